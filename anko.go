@@ -3,14 +3,14 @@
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"os"
-	"strings"
+	"path/filepath"
+	"regexp"
 
+	"github.com/mattn/anko/ankotest"
 	"github.com/mattn/anko/core"
 	"github.com/mattn/anko/env"
 	_ "github.com/mattn/anko/packages"
@@ -22,6 +22,10 @@ const version = "0.1.8"
 
 var (
 	flagExecute string
+	flagCompile string
+	flagCover   string
+	flagProfile string
+	flagStrict  bool
 	file        string
 	args        []string
 	e           *env.Env
@@ -30,9 +34,15 @@ var (
 func main() {
 	var exitCode int
 
+	if len(os.Args) > 1 && os.Args[1] == "test" {
+		os.Exit(runTest(os.Args[2:]))
+	}
+
 	parseFlags()
 	setupEnv()
-	if flagExecute != "" || flag.NArg() > 0 {
+	if flagCompile != "" {
+		exitCode = runCompile()
+	} else if flagExecute != "" || flag.NArg() > 0 {
 		exitCode = runNonInteractive()
 	} else {
 		exitCode = runInteractive()
@@ -44,6 +54,10 @@ func main() {
 func parseFlags() {
 	flagVersion := flag.Bool("v", false, "prints out the version and then exits")
 	flag.StringVar(&flagExecute, "e", "", "execute the Anko code")
+	flag.StringVar(&flagCover, "cover", "", "write the coverage of the script to the named file, as HTML if it ends with .html, as annotated text if it ends with .txt, otherwise as a Go coverprofile")
+	flag.StringVar(&flagProfile, "cpuprofile", "", "write a pprof CPU profile of the script to the named file")
+	flag.BoolVar(&flagStrict, "strict", false, "check the type annotations of the script before running it, then run it in strict mode with the annotations enforced")
+	flag.StringVar(&flagCompile, "compile", "", "compile the script file into the named .ankc file instead of running it")
 	flag.Parse()
 
 	if *flagVersion {
@@ -66,7 +80,122 @@ func setupEnv() {
 	core.Import(e)
 }
 
+// runTest runs the tests of the *_test.ank files in the paths of the arguments
+func runTest(arguments []string) int {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagJSON := flagSet.Bool("json", false, "write the results as go test -json events")
+	flagJUnit := flagSet.String("junit", "", "write the results as JUnit XML to the named file")
+	flagRun := flagSet.String("run", "", "run only the tests matching the regular expression")
+	flagVerbose := flagSet.Bool("v", false, "write all tests and their logs, not only failed tests")
+	err := flagSet.Parse(arguments)
+	if err != nil {
+		return 2
+	}
+
+	var run *regexp.Regexp
+	if *flagRun != "" {
+		run, err = regexp.Compile(*flagRun)
+		if err != nil {
+			fmt.Println("Run error:", err)
+			return 2
+		}
+	}
+
+	files, err := ankotest.FindFiles(flagSet.Args())
+	if err != nil {
+		fmt.Println("Find error:", err)
+		return 2
+	}
+
+	var results []ankotest.Result
+	failed := false
+	for _, file := range files {
+		fileResults, err := ankotest.RunFile(file, run)
+		if err != nil {
+			fmt.Println("FAIL", err)
+			failed = true
+			continue
+		}
+		results = append(results, fileResults...)
+	}
+
+	if *flagJSON {
+		err = ankotest.WriteJSON(os.Stdout, results)
+	} else {
+		err = ankotest.WriteText(os.Stdout, results, *flagVerbose)
+	}
+	if err != nil {
+		fmt.Println("Write error:", err)
+		return 8
+	}
+
+	if *flagJUnit != "" {
+		outFile, err := os.Create(*flagJUnit)
+		if err != nil {
+			fmt.Println("Create error:", err)
+			return 8
+		}
+		err = ankotest.WriteJUnit(outFile, results)
+		if err != nil {
+			outFile.Close()
+			fmt.Println("JUnit error:", err)
+			return 8
+		}
+		err = outFile.Close()
+		if err != nil {
+			fmt.Println("Close error:", err)
+			return 8
+		}
+	}
+
+	if failed || ankotest.Failed(results) {
+		return 1
+	}
+	return 0
+}
+
+func runCompile() int {
+	if file == "" {
+		fmt.Println("Compile error: no script file")
+		return 2
+	}
+	sourceBytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		fmt.Println("ReadFile error:", err)
+		return 2
+	}
+
+	program, err := vm.Compile(string(sourceBytes))
+	if err != nil {
+		fmt.Println("Compile error:", err)
+		return 3
+	}
+
+	outFile, err := os.Create(flagCompile)
+	if err != nil {
+		fmt.Println("Create error:", err)
+		return 5
+	}
+	err = program.Encode(outFile)
+	if err != nil {
+		outFile.Close()
+		fmt.Println("Encode error:", err)
+		return 5
+	}
+	err = outFile.Close()
+	if err != nil {
+		fmt.Println("Close error:", err)
+		return 5
+	}
+
+	return 0
+}
+
 func runNonInteractive() int {
+	if flagExecute == "" && filepath.Ext(file) == ".ankc" {
+		return runCompiled()
+	}
+
 	var source string
 	if flagExecute != "" {
 		source = flagExecute
@@ -79,89 +208,136 @@ func runNonInteractive() int {
 		source = string(sourceBytes)
 	}
 
-	_, err := vm.Execute(e, nil, source)
+	stmt, err := parser.ParseSrc(source)
 	if err != nil {
 		fmt.Println("Execute error:", err)
 		return 4
 	}
 
-	return 0
-}
-
-func runInteractive() int {
-	var following bool
-	var source string
-	scanner := bufio.NewScanner(os.Stdin)
-
-	parser.EnableErrorVerbose()
-
-	for {
-		if following {
-			source += "\n"
-			fmt.Print("  ")
-		} else {
-			fmt.Print("> ")
+	options := &vm.Options{}
+	if flagStrict {
+		errs := vm.Check(e, stmt)
+		for _, err := range errs {
+			if vmErr, ok := err.(*vm.Error); ok {
+				fmt.Printf("Check error: %v:%v: %v\n", vmErr.Pos.Line, vmErr.Pos.Column, vmErr)
+			} else {
+				fmt.Println("Check error:", err)
+			}
 		}
-
-		if !scanner.Scan() {
-			break
+		if len(errs) > 0 {
+			return 8
 		}
-		source += scanner.Text()
-		if source == "" {
-			continue
+		options.StrictTypes = true
+		options.Strict = true
+	}
+	if flagCover != "" {
+		options.Coverage = vm.NewCoverage()
+		filename := file
+		if flagExecute != "" {
+			filename = "-e"
 		}
-		if source == "quit()" {
-			break
+		err = options.Coverage.AddFile(filename, source, stmt)
+		if err != nil {
+			fmt.Println("Coverage error:", err)
+			return 6
 		}
+	}
 
-		stmts, err := parser.ParseSrc(source)
-
-		if e, ok := err.(*parser.Error); ok {
-			es := e.Error()
-			if strings.HasPrefix(es, "syntax error: unexpected") {
-				if strings.HasPrefix(es, "syntax error: unexpected $end,") {
-					following = true
-					continue
-				}
-			} else {
-				if e.Pos.Column == len(source) && !e.Fatal {
-					fmt.Fprintln(os.Stderr, e)
-					following = true
-					continue
-				}
-				if e.Error() == "unexpected EOF" {
-					following = true
-					continue
-				}
-			}
+	if flagProfile != "" {
+		filename := file
+		if flagExecute != "" {
+			filename = "-e"
 		}
+		options.Profiler = vm.NewProfiler(filename)
+	}
 
-		following = false
-		source = ""
-		var v interface{}
-
-		if err == nil {
-			v, err = vm.Run(e, nil, stmts)
+	_, err = vm.Run(e, options, stmt)
+	if options.Profiler != nil {
+		exitCode := writeProfile(options.Profiler)
+		if exitCode != 0 {
+			return exitCode
 		}
-		if err != nil {
-			if e, ok := err.(*vm.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else if e, ok := err.(*parser.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else {
-				fmt.Fprintln(os.Stderr, err)
-			}
-			continue
+	}
+	if options.Coverage != nil {
+		exitCode := writeCoverage(options.Coverage)
+		if exitCode != 0 {
+			return exitCode
 		}
+	}
+	if err != nil {
+		fmt.Println("Execute error:", err)
+		return 4
+	}
+
+	return 0
+}
 
-		fmt.Printf("%#v\n", v)
+func writeProfile(profiler *vm.Profiler) int {
+	outFile, err := os.Create(flagProfile)
+	if err != nil {
+		fmt.Println("Create error:", err)
+		return 7
+	}
+	err = profiler.WriteProfile(outFile)
+	if err != nil {
+		outFile.Close()
+		fmt.Println("Profile error:", err)
+		return 7
 	}
+	err = outFile.Close()
+	if err != nil {
+		fmt.Println("Close error:", err)
+		return 7
+	}
+	return 0
+}
 
-	if err := scanner.Err(); err != nil {
-		if err != io.EOF {
-			fmt.Fprintln(os.Stderr, "ReadString error:", err)
-			return 12
-		}
+func writeCoverage(coverage *vm.Coverage) int {
+	outFile, err := os.Create(flagCover)
+	if err != nil {
+		fmt.Println("Create error:", err)
+		return 6
+	}
+	switch filepath.Ext(flagCover) {
+	case ".html":
+		err = coverage.WriteHTML(outFile)
+	case ".txt":
+		err = coverage.WriteText(outFile)
+	default:
+		err = coverage.WriteProfile(outFile)
+	}
+	if err != nil {
+		outFile.Close()
+		fmt.Println("Coverage error:", err)
+		return 6
+	}
+	err = outFile.Close()
+	if err != nil {
+		fmt.Println("Close error:", err)
+		return 6
+	}
+
+	fmt.Printf("coverage: %.1f%% of statements\n", coverage.Percent())
+	return 0
+}
+
+func runCompiled() int {
+	inFile, err := os.Open(file)
+	if err != nil {
+		fmt.Println("ReadFile error:", err)
+		return 2
+	}
+	program, err := vm.DecodeProgram(inFile)
+	inFile.Close()
+	if err != nil {
+		fmt.Println("Decode error:", err)
+		return 2
+	}
+
+	_, err = program.Run(e, nil)
+	if err != nil {
+		fmt.Println("Execute error:", err)
+		return 4
 	}
 
 	return 0
@@ -5,14 +5,19 @@ package main
 import (
 	"bufio"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
 )
 
 var logger *log.Logger
@@ -69,6 +74,137 @@ func TestRunNonInteractiveExecute(t *testing.T) {
 	flagExecute = ""
 }
 
+func TestRunStrict(t *testing.T) {
+	setupEnv()
+	flagStrict = true
+
+	flagExecute = "var a: int64 = 1"
+	exitCode := runNonInteractive()
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+
+	flagExecute = "var a: int64 = \"a\""
+	exitCode = runNonInteractive()
+	if exitCode != 8 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 8)
+	}
+
+	flagExecute = "func f(): int64 { return a }; var a = \"a\"; f()"
+	exitCode = runNonInteractive()
+	if exitCode != 4 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 4)
+	}
+
+	flagExecute = "undefinedStrict = 1"
+	exitCode = runNonInteractive()
+	if exitCode != 4 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 4)
+	}
+
+	flagExecute = ""
+	flagStrict = false
+}
+
+func TestRunCompile(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	testDir := filepath.Join(filepath.Dir(filename), "core", "testdata")
+	tempDir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(tempDir)
+	setupEnv()
+
+	flagCompile = filepath.Join(tempDir, "broken.ankc")
+	file = filepath.Join(testDir, "broken.ank")
+	exitCode := runCompile()
+	if exitCode != 3 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 3)
+	}
+
+	flagCompile = filepath.Join(tempDir, "test.ankc")
+	file = filepath.Join(testDir, "test.ank")
+	exitCode = runCompile()
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+	flagCompile = ""
+
+	file = filepath.Join(tempDir, "test.ankc")
+	exitCode = runNonInteractive()
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+
+	file = filepath.Join(testDir, "not-found.ankc")
+	exitCode = runNonInteractive()
+	if exitCode != 2 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 2)
+	}
+
+	file = ""
+}
+
+func TestRunCover(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(tempDir)
+	setupEnv()
+
+	flagExecute = "a = 1\nif a > 1 {\n\ta = 2\n}"
+	flagCover = filepath.Join(tempDir, "cover.out")
+	exitCode := runNonInteractive()
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+	flagExecute = ""
+	flagCover = ""
+
+	profile, err := ioutil.ReadFile(filepath.Join(tempDir, "cover.out"))
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	expected := "mode: count\n-e:1.1,1.6 1 1\n-e:2.1,2.11 1 1\n-e:3.2,3.7 1 0\n"
+	if string(profile) != expected {
+		t.Fatalf("profile - received: %v - expected: %v", string(profile), expected)
+	}
+}
+
+func TestRunTest(t *testing.T) {
+	testDir := filepath.Join("ankotest", "testdata")
+	tempDir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	exitCode := runTest([]string{"-json", filepath.Join(testDir, "math_test.ank")})
+	if exitCode != 1 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 1)
+	}
+
+	junitFile := filepath.Join(tempDir, "junit.xml")
+	exitCode = runTest([]string{"-run", "^TestAdd$", "-junit", junitFile, testDir + "/..."})
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+	junit, err := ioutil.ReadFile(junitFile)
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	if !strings.Contains(string(junit), `<testcase name="TestAdd"`) {
+		t.Fatalf("junit - received: %v - expected: %v", string(junit), "TestAdd test case")
+	}
+
+	exitCode = runTest([]string{filepath.Join(testDir, "not-found")})
+	if exitCode != 2 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 2)
+	}
+}
+
 type testInteractive struct {
 	runLines   []string
 	runOutputs []string
@@ -88,10 +224,92 @@ func TestRunInteractive(t *testing.T) {
 		{runLines: []string{"a = 1", "if a == 1 {", "b = 1", "b = 2", "}", "a"}, runOutputs: []string{"1", "", "", "", "2", "1"}},
 		{runLines: []string{"a = 1", "for i = 0; i < 2; i++ {", "a++", "}", "a"}, runOutputs: []string{"1", "", "", "<nil>", "3"}},
 		{runLines: []string{"1 + 1", "// comment 1", "2 + 2 // comment 2", "// 3 + 3"}, runOutputs: []string{"2", "<nil>", "4", "<nil>"}},
+		{runLines: []string{`a = [1, "b", {"c": nil}]`, ":type a"}, runOutputs: []string{`[1, "b", {"c": <nil>}]`, "[]interface {}"}},
+		{runLines: []string{"a = 1", ":reset", "a = 2"}, runOutputs: []string{"1", "", "2"}},
+		{runLines: []string{":bogus"}, runError: "unknown command :bogus, :help prints the commands"},
 	}
 	runInteractiveTests(t, tests)
 }
 
+func TestFormatValue(t *testing.T) {
+	type point struct {
+		X int
+		y int
+	}
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: nil, expected: "<nil>"},
+		{value: int64(1), expected: "1"},
+		{value: 1.5, expected: "1.5"},
+		{value: "a", expected: `"a"`},
+		{value: []interface{}{int64(1), "b", nil}, expected: `[1, "b", <nil>]`},
+		{value: map[interface{}]interface{}{"b": int64(2), "a": []int{1}}, expected: `{"a": [1], "b": 2}`},
+		{value: point{X: 1, y: 2}, expected: "main.point{X: 1}"},
+		{value: &point{X: 1}, expected: "&main.point{X: 1}"},
+		{value: io.EOF, expected: "EOF"},
+		{value: time.Second, expected: "1s"},
+		{value: strings.ToUpper, expected: "func(string) string"},
+	}
+	for _, test := range tests {
+		value := formatValue(test.value)
+		if value != test.expected {
+			t.Errorf("formatValue %#v - received: %v - expected: %v", test.value, value, test.expected)
+		}
+	}
+}
+
+func TestCompleteWord(t *testing.T) {
+	setupEnv()
+	_, err := vm.Execute(e, nil, `strings = import("strings"); aModule = { "Name": 1 }; aValue = 1`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	tests := []struct {
+		line        string
+		head        string
+		completions []string
+	}{
+		{line: "1 + aV", head: "1 + ", completions: []string{"aValue"}},
+		{line: "retu", head: "", completions: []string{"return"}},
+		{line: "strings.ToU", head: "", completions: []string{"strings.ToUpper", "strings.ToUpperSpecial"}},
+		{line: "x = aModule.N", head: "x = ", completions: []string{"aModule.Name"}},
+		{line: `import("string`, head: `import("`, completions: []string{"strings"}},
+		{line: "unknown.a", head: "unknown.a", completions: nil},
+	}
+	for _, test := range tests {
+		head, completions, tail := completeWord(test.line+")", len(test.line))
+		if head != test.head || !reflect.DeepEqual(completions, test.completions) || tail != ")" {
+			t.Errorf("completeWord %q - received: %q %q %q - expected: %q %q %q", test.line, head, completions, tail, test.head, test.completions, ")")
+		}
+	}
+}
+
+func TestWriteAST(t *testing.T) {
+	stmt, err := parser.ParseSrc(`f(1, "a")`)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	var builder strings.Builder
+	writeAST(&builder, reflect.ValueOf(stmt), "")
+	expected := `StmtsStmt 0:0
+  Stmts:
+    - ExprStmt 1:1
+        Expr: CallExpr 1:1
+          Name: "f"
+          SubExprs:
+            - LiteralExpr 1:3
+                Literal: 1
+            - LiteralExpr 1:6
+                Literal: "a"
+`
+	if builder.String() != expected {
+		t.Errorf("writeAST - received:\n%v - expected:\n%v", builder.String(), expected)
+	}
+}
+
 func runInteractiveTests(t *testing.T, tests []testInteractive) {
 	// create logger
 	// Note: logger is used for debugging since real stdout cannot be used
@@ -0,0 +1,289 @@
+// Package ankotest runs anko script tests.
+//
+// Tests are the top level functions named TestXxx with one parameter in files ending with _test.ank.
+// Each test runs in a new env with the core builtins, the file is run first and then the test function
+// is called with a t module that has the helpers:
+//
+//	t.assertEqual(actual, expected [, message...])
+//	t.assertNotEqual(actual, expected [, message...])
+//	t.fail([message...])
+//	t.skip([message...])
+//	t.log(args...)
+//	t.name()
+//
+// A failed assertion, fail, or skip stops the test.
+package ankotest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/core"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// Status is the outcome of a test.
+type Status string
+
+const (
+	// StatusPass when the test passed
+	StatusPass Status = "pass"
+	// StatusFail when the test failed
+	StatusFail Status = "fail"
+	// StatusSkip when the test was skipped
+	StatusSkip Status = "skip"
+)
+
+type (
+	// Failure is a failed assertion or a run error of a test.
+	Failure struct {
+		Message string
+		Pos     ast.Position
+	}
+
+	// Result is the result of running a test.
+	Result struct {
+		File     string
+		Name     string
+		Status   Status
+		Start    time.Time
+		Elapsed  time.Duration
+		Failures []Failure
+		Output   []string
+	}
+
+	// testState is the state of a running test, shared with the t module
+	testState struct {
+		result  *Result
+		stopped bool
+	}
+
+	// stopTest is the panic value used to stop a test
+	stopTest struct{}
+)
+
+var testNameRegexp = regexp.MustCompile(`^Test($|[^a-z])`)
+
+// FindFiles returns the test files of the paths, sorted by name.
+// A path can be a file, a directory, or a directory followed by /... to include sub directories.
+// No paths is the current directory.
+func FindFiles(paths []string) ([]string, error) {
+	if len(paths) < 1 {
+		paths = []string{"."}
+	}
+
+	var files []string
+	for _, path := range paths {
+		recursive := false
+		if path == "..." || strings.HasSuffix(path, "/...") {
+			recursive = true
+			path = strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+			if path == "" {
+				path = "."
+			}
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		if recursive {
+			err = filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && isTestFile(name) {
+					files = append(files, name)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() && isTestFile(info.Name()) {
+				files = append(files, filepath.Join(path, info.Name()))
+			}
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// isTestFile returns true if name is an anko test file name
+func isTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.ank")
+}
+
+// RunFile runs the tests of a test file in file order.
+// If run is not nil only the tests with names matching run are run.
+// Returns an error if the file cannot be read or parsed.
+func RunFile(filename string, run *regexp.Regexp) ([]Result, error) {
+	source, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		if parserErr, ok := err.(*parser.Error); ok {
+			return nil, fmt.Errorf("%s:%d:%d: %v", filename, parserErr.Pos.Line, parserErr.Pos.Column, err)
+		}
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	var results []Result
+	for _, funcExpr := range findTests(stmt) {
+		if run != nil && !run.MatchString(funcExpr.Name) {
+			continue
+		}
+		results = append(results, runTest(filename, stmt, funcExpr))
+	}
+	return results, nil
+}
+
+// findTests returns the test functions of the top level statements
+func findTests(stmt ast.Stmt) []*ast.FuncExpr {
+	stmts, ok := stmt.(*ast.StmtsStmt)
+	if !ok {
+		return nil
+	}
+	var tests []*ast.FuncExpr
+	for _, stmt := range stmts.Stmts {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		funcExpr, ok := exprStmt.Expr.(*ast.FuncExpr)
+		if !ok || !testNameRegexp.MatchString(funcExpr.Name) || len(funcExpr.Params) != 1 || funcExpr.VarArg {
+			continue
+		}
+		tests = append(tests, funcExpr)
+	}
+	return tests
+}
+
+// runTest runs the file then calls the test function in a new env
+func runTest(filename string, stmt ast.Stmt, funcExpr *ast.FuncExpr) Result {
+	state := &testState{result: &Result{File: filename, Name: funcExpr.Name, Start: time.Now()}}
+
+	e := env.NewEnv()
+	core.Import(e)
+	t, err := newTestModule(state)
+	if err == nil {
+		_, err = vm.Run(e, nil, stmt)
+	}
+	if err == nil {
+		callExpr := &ast.CallExpr{Name: funcExpr.Name, SubExprs: []ast.Expr{&ast.LiteralExpr{Literal: reflect.ValueOf(t)}}}
+		callExpr.SetPosition(funcExpr.Position())
+		_, err = vm.Run(e, nil, &ast.ExprStmt{Expr: callExpr})
+	}
+
+	result := state.result
+	result.Elapsed = time.Since(result.Start)
+
+	if err != nil {
+		var pos ast.Position
+		if vmErr, ok := err.(*vm.Error); ok {
+			pos = vmErr.Pos
+		}
+		if state.stopped {
+			// the position of the stopping failure is the position of the call to the t module
+			if len(result.Failures) > 0 {
+				result.Failures[len(result.Failures)-1].Pos = pos
+			}
+		} else {
+			result.Failures = append(result.Failures, Failure{Message: err.Error(), Pos: pos})
+		}
+	}
+
+	switch {
+	case len(result.Failures) > 0:
+		result.Status = StatusFail
+	case state.stopped:
+		result.Status = StatusSkip
+	default:
+		result.Status = StatusPass
+	}
+	return *result
+}
+
+// newTestModule creates the t module of a test
+func newTestModule(state *testState) (*env.Env, error) {
+	t := env.NewEnv()
+
+	fail := func(message string) {
+		state.result.Failures = append(state.result.Failures, Failure{Message: message})
+		state.stopped = true
+		panic(stopTest{})
+	}
+
+	definitions := map[string]interface{}{
+		"assertEqual": func(actual interface{}, expected interface{}, args ...interface{}) {
+			if !vm.Equal(actual, expected) {
+				fail(messagePrefix(args) + fmt.Sprintf("assertEqual failed - received: %v - expected: %v", actual, expected))
+			}
+		},
+		"assertNotEqual": func(actual interface{}, expected interface{}, args ...interface{}) {
+			if vm.Equal(actual, expected) {
+				fail(messagePrefix(args) + fmt.Sprintf("assertNotEqual failed - received: %v", actual))
+			}
+		},
+		"fail": func(args ...interface{}) {
+			if len(args) < 1 {
+				fail("failed")
+			}
+			fail(fmt.Sprint(args...))
+		},
+		"skip": func(args ...interface{}) {
+			if len(args) > 0 {
+				state.result.Output = append(state.result.Output, fmt.Sprint(args...))
+			}
+			state.stopped = true
+			panic(stopTest{})
+		},
+		"log": func(args ...interface{}) {
+			state.result.Output = append(state.result.Output, fmt.Sprint(args...))
+		},
+		"name": func() string {
+			return state.result.Name
+		},
+	}
+	for symbol, value := range definitions {
+		err := t.Define(symbol, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// messagePrefix returns the optional message arguments of an assertion as a prefix
+func messagePrefix(args []interface{}) string {
+	if len(args) < 1 {
+		return ""
+	}
+	return fmt.Sprint(args...) + ": "
+}
@@ -0,0 +1,163 @@
+package ankotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFindFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		paths    []string
+		expected []string
+	}{
+		{paths: []string{"testdata"}, expected: []string{filepath.Join("testdata", "math_test.ank")}},
+		{paths: []string{"testdata/..."}, expected: []string{filepath.Join("testdata", "math_test.ank"), filepath.Join("testdata", "sub", "sub_test.ank")}},
+		{paths: []string{"testdata/sub/broken.ank"}, expected: []string{"testdata/sub/broken.ank"}},
+	}
+	for _, test := range tests {
+		files, err := FindFiles(test.paths)
+		if err != nil {
+			t.Errorf("FindFiles %v error: %v", test.paths, err)
+			continue
+		}
+		if !reflect.DeepEqual(files, test.expected) {
+			t.Errorf("FindFiles %v - received: %v - expected: %v", test.paths, files, test.expected)
+		}
+	}
+
+	_, err := FindFiles([]string{"testdata/not-found"})
+	if err == nil {
+		t.Errorf("FindFiles error - received: %v - expected: not found error", err)
+	}
+}
+
+func TestRunFile(t *testing.T) {
+	t.Parallel()
+
+	results, err := RunFile(filepath.Join("testdata", "math_test.ank"), nil)
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+
+	tests := []struct {
+		name     string
+		status   Status
+		failures []string
+		output   []string
+	}{
+		{name: "TestAdd", status: StatusPass, output: []string{"adding"}},
+		{name: "TestAddFail", status: StatusFail, failures: []string{"13:2: one plus one: assertEqual failed - received: 2 - expected: 3"}},
+		{name: "TestSkip", status: StatusSkip, output: []string{"not ready"}},
+		{name: "TestError", status: StatusFail, failures: []string{"23:2: index out of range"}},
+		{name: "TestFail", status: StatusFail, failures: []string{"27:2: failed"}},
+	}
+	if len(results) != len(tests) {
+		t.Fatalf("RunFile results - received: %v - expected: %v", len(results), len(tests))
+	}
+	for i, test := range tests {
+		result := results[i]
+		if result.Name != test.name || result.Status != test.status {
+			t.Errorf("result %v - received: %v %v - expected: %v %v", i, result.Name, result.Status, test.name, test.status)
+		}
+		var failures []string
+		for _, failure := range result.Failures {
+			failures = append(failures, failure.String())
+		}
+		if !reflect.DeepEqual(failures, test.failures) {
+			t.Errorf("%v failures - received: %v - expected: %v", test.name, failures, test.failures)
+		}
+		if !reflect.DeepEqual(result.Output, test.output) {
+			t.Errorf("%v output - received: %v - expected: %v", test.name, result.Output, test.output)
+		}
+	}
+
+	results, err = RunFile(filepath.Join("testdata", "math_test.ank"), regexp.MustCompile("^TestAdd$"))
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	if len(results) != 1 || results[0].Name != "TestAdd" {
+		t.Errorf("RunFile with run - received: %v - expected: %v", results, "TestAdd")
+	}
+
+	_, err = RunFile(filepath.Join("testdata", "sub", "broken.ank"), nil)
+	if err == nil || !strings.HasPrefix(err.Error(), filepath.Join("testdata", "sub", "broken.ank")+":1:") {
+		t.Errorf("RunFile error - received: %v - expected: parse error with position", err)
+	}
+}
+
+func TestReports(t *testing.T) {
+	t.Parallel()
+
+	results, err := RunFile(filepath.Join("testdata", "math_test.ank"), nil)
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	if !Failed(results) {
+		t.Errorf("Failed - received: %v - expected: %v", false, true)
+	}
+
+	var text bytes.Buffer
+	err = WriteText(&text, results, false)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	for _, line := range []string{"--- FAIL: TestAddFail", "    testdata/math_test.ank:27:2: failed", "FAIL"} {
+		if !strings.Contains(text.String(), line) {
+			t.Errorf("WriteText - received:\n%v - expected line: %v", text.String(), line)
+		}
+	}
+	if strings.Contains(text.String(), "TestSkip") {
+		t.Errorf("WriteText - received:\n%v - expected no passed or skipped tests", text.String())
+	}
+
+	var jsonBuffer bytes.Buffer
+	err = WriteJSON(&jsonBuffer, results)
+	if err != nil {
+		t.Fatal("WriteJSON error:", err)
+	}
+	actions := make(map[string]string)
+	decoder := json.NewDecoder(&jsonBuffer)
+	for decoder.More() {
+		var event testEvent
+		err = decoder.Decode(&event)
+		if err != nil {
+			t.Fatal("Decode error:", err)
+		}
+		if event.Action != "output" && event.Action != "run" {
+			actions[event.Test] = event.Action
+		}
+	}
+	expectedActions := map[string]string{"": "fail", "TestAdd": "pass", "TestAddFail": "fail", "TestSkip": "skip", "TestError": "fail", "TestFail": "fail"}
+	if !reflect.DeepEqual(actions, expectedActions) {
+		t.Errorf("WriteJSON actions - received: %v - expected: %v", actions, expectedActions)
+	}
+
+	var junit bytes.Buffer
+	err = WriteJUnit(&junit, results)
+	if err != nil {
+		t.Fatal("WriteJUnit error:", err)
+	}
+	var testSuites junitTestSuites
+	err = xml.Unmarshal(junit.Bytes(), &testSuites)
+	if err != nil {
+		t.Fatal("Unmarshal error:", err)
+	}
+	if len(testSuites.TestSuites) != 1 {
+		t.Fatalf("WriteJUnit test suites - received: %v - expected: %v", len(testSuites.TestSuites), 1)
+	}
+	suite := testSuites.TestSuites[0]
+	if suite.Tests != 5 || suite.Failures != 3 || suite.Skipped != 1 {
+		t.Errorf("WriteJUnit counts - received: %v %v %v - expected: %v %v %v", suite.Tests, suite.Failures, suite.Skipped, 5, 3, 1)
+	}
+	if suite.TestCases[1].Failure == nil || suite.TestCases[1].Failure.Message != "one plus one: assertEqual failed - received: 2 - expected: 3" {
+		t.Errorf("WriteJUnit failure - received: %v", suite.TestCases[1].Failure)
+	}
+}
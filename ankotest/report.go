@@ -0,0 +1,227 @@
+package ankotest
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type (
+	// testEvent is an event of the go test -json format
+	testEvent struct {
+		Time    *time.Time `json:",omitempty"`
+		Action  string
+		Package string   `json:",omitempty"`
+		Test    string   `json:",omitempty"`
+		Elapsed *float64 `json:",omitempty"`
+		Output  string   `json:",omitempty"`
+	}
+
+	junitTestSuites struct {
+		XMLName    xml.Name         `xml:"testsuites"`
+		TestSuites []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name      string          `xml:"name,attr"`
+		Tests     int             `xml:"tests,attr"`
+		Failures  int             `xml:"failures,attr"`
+		Skipped   int             `xml:"skipped,attr"`
+		Time      string          `xml:"time,attr"`
+		TestCases []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitMessage `xml:"failure,omitempty"`
+		Skipped   *junitMessage `xml:"skipped,omitempty"`
+		SystemOut *junitOutput  `xml:"system-out,omitempty"`
+	}
+
+	junitOutput struct {
+		Contents string `xml:",cdata"`
+	}
+
+	junitMessage struct {
+		Message  string `xml:"message,attr"`
+		Contents string `xml:",cdata"`
+	}
+)
+
+// Failed returns true if any of the results failed.
+func Failed(results []Result) bool {
+	for _, result := range results {
+		if result.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the failure as file position and message.
+func (failure Failure) String() string {
+	if failure.Pos.Line < 1 {
+		return failure.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", failure.Pos.Line, failure.Pos.Column, failure.Message)
+}
+
+// lines returns the output and failure lines of the result
+func (result Result) lines() []string {
+	lines := make([]string, 0, len(result.Output)+len(result.Failures))
+	lines = append(lines, result.Output...)
+	for _, failure := range result.Failures {
+		lines = append(lines, result.File+":"+failure.String())
+	}
+	return lines
+}
+
+// WriteText writes the results like go test.
+// If verbose is true all tests are written, otherwise only failed tests.
+func WriteText(w io.Writer, results []Result, verbose bool) error {
+	writer := bufio.NewWriter(w)
+	for _, result := range results {
+		if !verbose && result.Status != StatusFail {
+			continue
+		}
+		if verbose {
+			fmt.Fprintf(writer, "=== RUN   %s\n", result.Name)
+		}
+		fmt.Fprintf(writer, "--- %s: %s (%.2fs)\n", statusText(result.Status), result.Name, result.Elapsed.Seconds())
+		for _, line := range result.lines() {
+			fmt.Fprintf(writer, "    %s\n", line)
+		}
+	}
+	if Failed(results) {
+		fmt.Fprintln(writer, "FAIL")
+	} else {
+		fmt.Fprintln(writer, "PASS")
+	}
+	return writer.Flush()
+}
+
+// statusText returns the go test text of a status
+func statusText(status Status) string {
+	switch status {
+	case StatusPass:
+		return "PASS"
+	case StatusSkip:
+		return "SKIP"
+	}
+	return "FAIL"
+}
+
+// WriteJSON writes the results as go test -json events, with the file as the package.
+func WriteJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	var files []string
+	failed := make(map[string]bool)
+	elapsed := make(map[string]time.Duration)
+	for _, result := range results {
+		if _, ok := elapsed[result.File]; !ok {
+			files = append(files, result.File)
+		}
+		elapsed[result.File] += result.Elapsed
+		if result.Status == StatusFail {
+			failed[result.File] = true
+		}
+
+		start := result.Start
+		events := []testEvent{
+			{Time: &start, Action: "run", Package: result.File, Test: result.Name},
+			{Time: &start, Action: "output", Package: result.File, Test: result.Name, Output: "=== RUN   " + result.Name + "\n"},
+		}
+		for _, line := range result.lines() {
+			events = append(events, testEvent{Time: &start, Action: "output", Package: result.File, Test: result.Name, Output: "    " + line + "\n"})
+		}
+		end := result.Start.Add(result.Elapsed)
+		seconds := result.Elapsed.Seconds()
+		events = append(events,
+			testEvent{Time: &end, Action: "output", Package: result.File, Test: result.Name, Output: fmt.Sprintf("--- %s: %s (%.2fs)\n", statusText(result.Status), result.Name, seconds)},
+			testEvent{Time: &end, Action: string(result.Status), Package: result.File, Test: result.Name, Elapsed: &seconds},
+		)
+		for i := range events {
+			err := encoder.Encode(&events[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, file := range files {
+		action := "pass"
+		if failed[file] {
+			action = "fail"
+		}
+		seconds := elapsed[file].Seconds()
+		err := encoder.Encode(&testEvent{Action: action, Package: file, Elapsed: &seconds})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJUnit writes the results as JUnit XML with a test suite for each file.
+func WriteJUnit(w io.Writer, results []Result) error {
+	var testSuites junitTestSuites
+	suites := make(map[string]int)
+	elapsed := make(map[string]time.Duration)
+	for _, result := range results {
+		index, ok := suites[result.File]
+		if !ok {
+			index = len(testSuites.TestSuites)
+			suites[result.File] = index
+			testSuites.TestSuites = append(testSuites.TestSuites, junitTestSuite{Name: result.File})
+		}
+		suite := &testSuites.TestSuites[index]
+		elapsed[result.File] += result.Elapsed
+
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: result.File,
+			Time:      fmt.Sprintf("%.3f", result.Elapsed.Seconds()),
+		}
+		if len(result.Output) > 0 {
+			testCase.SystemOut = &junitOutput{Contents: strings.Join(result.Output, "\n") + "\n"}
+		}
+		suite.Tests++
+		switch result.Status {
+		case StatusFail:
+			suite.Failures++
+			testCase.Failure = &junitMessage{Message: result.Failures[0].Message}
+			for _, failure := range result.Failures {
+				testCase.Failure.Contents += result.File + ":" + failure.String() + "\n"
+			}
+		case StatusSkip:
+			suite.Skipped++
+			testCase.Skipped = &junitMessage{}
+			if len(result.Output) > 0 {
+				testCase.Skipped.Message = result.Output[len(result.Output)-1]
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	for i := range testSuites.TestSuites {
+		testSuites.TestSuites[i].Time = fmt.Sprintf("%.3f", elapsed[testSuites.TestSuites[i].Name].Seconds())
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+	err = encoder.Encode(&testSuites)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
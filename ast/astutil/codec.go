@@ -0,0 +1,429 @@
+package astutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/mattn/anko/ast"
+)
+
+// FormatVersion is the version of the format written by Encode.
+// It is increased when the encoding of statements changes in an incompatible way.
+const FormatVersion = 6
+
+// formatName is the format name written in the header of encoded statements.
+const formatName = "anko"
+
+var (
+	reflectValueType = reflect.TypeOf(reflect.Value{})
+	interfaceType    = reflect.TypeOf((*interface{})(nil)).Elem()
+
+	// nodeTypes are all the statement, expression, and operator types that can be encoded
+	nodeTypes = map[string]reflect.Type{}
+)
+
+func init() {
+	nodes := []interface{}{
+		// statements
+		&ast.StmtsStmt{}, &ast.ExprStmt{}, &ast.IfStmt{}, &ast.TryStmt{}, &ast.CatchStmt{}, &ast.ForStmt{}, &ast.CForStmt{},
+		&ast.LoopStmt{}, &ast.BreakStmt{}, &ast.ContinueStmt{}, &ast.ReturnStmt{}, &ast.ThrowStmt{},
+		&ast.ModuleStmt{}, &ast.SwitchStmt{}, &ast.SwitchCaseStmt{}, &ast.VarStmt{}, &ast.LetsStmt{},
+		&ast.LetMapItemStmt{}, &ast.GoroutineStmt{}, &ast.DeleteStmt{}, &ast.CloseStmt{}, &ast.ChanStmt{},
+		// expressions
+		&ast.OpExpr{}, &ast.LiteralExpr{}, &ast.ArrayExpr{}, &ast.MapExpr{}, &ast.IdentExpr{}, &ast.UnaryExpr{},
+		&ast.AddrExpr{}, &ast.DerefExpr{}, &ast.ParenExpr{}, &ast.NilCoalescingOpExpr{}, &ast.TernaryOpExpr{},
+		&ast.CallExpr{}, &ast.AnonCallExpr{}, &ast.MemberExpr{}, &ast.ItemExpr{}, &ast.SliceExpr{},
+		&ast.FuncExpr{}, &ast.LetsExpr{}, &ast.ChanExpr{}, &ast.ImportExpr{}, &ast.MakeExpr{},
+		&ast.MakeTypeExpr{}, &ast.LenExpr{}, &ast.IncludeExpr{},
+		// operators
+		&ast.BinaryOperator{}, &ast.ComparisonOperator{}, &ast.AddOperator{}, &ast.MultiplyOperator{},
+	}
+	for _, node := range nodes {
+		nodeType := reflect.TypeOf(node).Elem()
+		nodeTypes[nodeType.Name()] = nodeType
+	}
+}
+
+// encodedFile is the top level of the encoded format
+type encodedFile struct {
+	Format  string      `json:"format"`
+	Version int         `json:"version"`
+	Stmt    interface{} `json:"stmt"`
+}
+
+// Encode writes stmt to w in a stable JSON format that Decode can read back.
+// Every node is written with its type name and position.
+// Literals can be nil, bool, string, or any integer or float kind.
+func Encode(w io.Writer, stmt ast.Stmt) error {
+	value, err := encodeNode(reflect.ValueOf(&stmt).Elem())
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(encodedFile{Format: formatName, Version: FormatVersion, Stmt: value})
+}
+
+// Decode reads a statement written by Encode.
+func Decode(r io.Reader) (ast.Stmt, error) {
+	var file encodedFile
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	err := decoder.Decode(&file)
+	if err != nil {
+		return nil, err
+	}
+	if file.Format != formatName {
+		return nil, fmt.Errorf("unknown format %q", file.Format)
+	}
+	if file.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported format version %v, expected version %v", file.Version, FormatVersion)
+	}
+	if file.Stmt == nil {
+		return nil, nil
+	}
+
+	var stmt ast.Stmt
+	rv, err := decodeValue(file.Stmt, reflect.TypeOf(&stmt).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return rv.Interface().(ast.Stmt), nil
+}
+
+// encodeNode encodes a statement, expression, or operator interface value
+func encodeNode(rv reflect.Value) (interface{}, error) {
+	if rv.IsNil() {
+		return nil, nil
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct || nodeTypes[rv.Elem().Type().Name()] != rv.Elem().Type() {
+		return nil, fmt.Errorf("unknown statement/expression %v", rv.Type())
+	}
+
+	node, err := encodeStruct(rv.Elem())
+	if err != nil {
+		return nil, err
+	}
+	node["node"] = rv.Elem().Type().Name()
+	pos := rv.Interface().(ast.Pos).Position()
+	if pos.Line != 0 || pos.Column != 0 {
+		node["pos"] = []int{pos.Line, pos.Column}
+	}
+	return node, nil
+}
+
+// encodeStruct encodes the exported fields of a struct, skipping embedded fields and zero values
+func encodeStruct(rv reflect.Value) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, rv.NumField()+2)
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if field.Anonymous || field.PkgPath != "" {
+			continue
+		}
+		value, err := encodeValue(rv.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%v.%v: %v", rv.Type().Name(), field.Name, err)
+		}
+		if value != nil {
+			values[field.Name] = value
+		}
+	}
+	return values, nil
+}
+
+// encodeValue encodes a field value, returns nil for zero values
+func encodeValue(rv reflect.Value) (interface{}, error) {
+	if rv.Type() == reflectValueType {
+		return encodeLiteral(rv.Interface().(reflect.Value))
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		return encodeNode(rv)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return encodeStruct(rv.Elem())
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		values := make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			value, err := encodeValue(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	case reflect.String:
+		if rv.Len() == 0 {
+			return nil, nil
+		}
+		return rv.String(), nil
+	case reflect.Bool:
+		if !rv.Bool() {
+			return nil, nil
+		}
+		return true, nil
+	case reflect.Int:
+		if rv.Int() == 0 {
+			return nil, nil
+		}
+		return rv.Int(), nil
+	}
+
+	return nil, fmt.Errorf("cannot encode type %v", rv.Type())
+}
+
+// encodeLiteral encodes a literal value as its kind and its value as a string
+func encodeLiteral(rv reflect.Value) (interface{}, error) {
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	if rv.Kind() == reflect.Interface && rv.IsNil() {
+		return map[string]interface{}{"kind": "nil"}, nil
+	}
+	if rv.Type().PkgPath() != "" {
+		return nil, fmt.Errorf("cannot encode literal of type %v", rv.Type())
+	}
+
+	var value string
+	switch rv.Kind() {
+	case reflect.Bool:
+		value = strconv.FormatBool(rv.Bool())
+	case reflect.String:
+		value = rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value = strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		value = strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		value = strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	default:
+		return nil, fmt.Errorf("cannot encode literal of type %v", rv.Type())
+	}
+	return map[string]interface{}{"kind": rv.Kind().String(), "value": value}, nil
+}
+
+// decodeValue decodes data into a value of type rt
+func decodeValue(data interface{}, rt reflect.Type) (reflect.Value, error) {
+	if rt == reflectValueType {
+		literal, err := decodeLiteral(data)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(literal), nil
+	}
+	if data == nil {
+		return reflect.Zero(rt), nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Interface:
+		node, err := decodeNode(data)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if !node.Type().Implements(rt) {
+			return reflect.Value{}, fmt.Errorf("type %v does not implement %v", node.Type(), rt)
+		}
+		return node, nil
+	case reflect.Ptr:
+		values, ok := data.(map[string]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected object for %v but got %T", rt, data)
+		}
+		rv := reflect.New(rt.Elem())
+		err := decodeStruct(values, rv.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return rv, nil
+	case reflect.Slice:
+		values, ok := data.([]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected array for %v but got %T", rt, data)
+		}
+		rv := reflect.MakeSlice(rt, len(values), len(values))
+		for i := 0; i < len(values); i++ {
+			value, err := decodeValue(values[i], rt.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			rv.Index(i).Set(value)
+		}
+		return rv, nil
+	case reflect.String:
+		value, ok := data.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string but got %T", data)
+		}
+		return reflect.ValueOf(value).Convert(rt), nil
+	case reflect.Bool:
+		value, ok := data.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected bool but got %T", data)
+		}
+		return reflect.ValueOf(value).Convert(rt), nil
+	case reflect.Int:
+		number, ok := data.(json.Number)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected number but got %T", data)
+		}
+		value, err := number.Int64()
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(value).Convert(rt), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot decode type %v", rt)
+}
+
+// decodeNode decodes a statement, expression, or operator
+func decodeNode(data interface{}) (reflect.Value, error) {
+	values, ok := data.(map[string]interface{})
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("expected object for node but got %T", data)
+	}
+	name, _ := values["node"].(string)
+	nodeType, ok := nodeTypes[name]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("unknown statement/expression %q", name)
+	}
+
+	rv := reflect.New(nodeType)
+	err := decodeStruct(values, rv.Elem())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if pos, ok := values["pos"]; ok {
+		lineColumn, ok := pos.([]interface{})
+		if !ok || len(lineColumn) != 2 {
+			return reflect.Value{}, fmt.Errorf("invalid position for %v", name)
+		}
+		var position ast.Position
+		line, err := decodeValue(lineColumn[0], reflect.TypeOf(position.Line))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		column, err := decodeValue(lineColumn[1], reflect.TypeOf(position.Column))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		position.Line, position.Column = int(line.Int()), int(column.Int())
+		rv.Interface().(ast.Pos).SetPosition(position)
+	}
+
+	return rv, nil
+}
+
+// decodeStruct decodes the exported fields of a struct.
+// Unknown fields are errors, so a newer encoding is not decoded with parts of it left out.
+func decodeStruct(values map[string]interface{}, rv reflect.Value) error {
+	for name := range values {
+		if name == "node" || name == "pos" {
+			continue
+		}
+		field, ok := rv.Type().FieldByName(name)
+		if !ok || field.Anonymous || field.PkgPath != "" || len(field.Index) > 1 {
+			return fmt.Errorf("%v: unknown field %q", rv.Type().Name(), name)
+		}
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if field.Anonymous || field.PkgPath != "" {
+			continue
+		}
+		data, ok := values[field.Name]
+		if !ok {
+			continue
+		}
+		value, err := decodeValue(data, field.Type)
+		if err != nil {
+			return fmt.Errorf("%v.%v: %v", rv.Type().Name(), field.Name, err)
+		}
+		rv.Field(i).Set(value)
+	}
+	return nil
+}
+
+// decodeLiteral decodes a literal value
+func decodeLiteral(data interface{}) (reflect.Value, error) {
+	if data == nil {
+		return reflect.Value{}, nil
+	}
+	values, ok := data.(map[string]interface{})
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("expected object for literal but got %T", data)
+	}
+	kind, _ := values["kind"].(string)
+	if kind == "nil" {
+		return reflect.New(interfaceType).Elem(), nil
+	}
+	value, ok := values["value"].(string)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("literal of kind %q has no value", kind)
+	}
+
+	for rt, bits := range literalTypes {
+		if rt.Kind().String() != kind {
+			continue
+		}
+		var err error
+		var rv reflect.Value
+		switch rt.Kind() {
+		case reflect.Bool:
+			var b bool
+			b, err = strconv.ParseBool(value)
+			rv = reflect.ValueOf(b)
+		case reflect.String:
+			rv = reflect.ValueOf(value)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var i int64
+			i, err = strconv.ParseInt(value, 10, bits)
+			rv = reflect.ValueOf(i)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			var u uint64
+			u, err = strconv.ParseUint(value, 10, bits)
+			rv = reflect.ValueOf(u)
+		case reflect.Float32, reflect.Float64:
+			var f float64
+			f, err = strconv.ParseFloat(value, bits)
+			rv = reflect.ValueOf(f)
+		}
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return rv.Convert(rt), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("unknown literal kind %q", kind)
+}
+
+// literalTypes are the types of literals that can be decoded and their size in bits
+var literalTypes = map[reflect.Type]int{
+	reflect.TypeOf(false):      0,
+	reflect.TypeOf(""):         0,
+	reflect.TypeOf(int(0)):     strconv.IntSize,
+	reflect.TypeOf(int8(0)):    8,
+	reflect.TypeOf(int16(0)):   16,
+	reflect.TypeOf(int32(0)):   32,
+	reflect.TypeOf(int64(0)):   64,
+	reflect.TypeOf(uint(0)):    strconv.IntSize,
+	reflect.TypeOf(uint8(0)):   8,
+	reflect.TypeOf(uint16(0)):  16,
+	reflect.TypeOf(uint32(0)):  32,
+	reflect.TypeOf(uint64(0)):  64,
+	reflect.TypeOf(uintptr(0)): 64,
+	reflect.TypeOf(float32(0)): 32,
+	reflect.TypeOf(float64(0)): 64,
+}
@@ -0,0 +1,163 @@
+package astutil
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/parser"
+)
+
+const codecSrc string = `
+module m {
+	b = 1
+}
+a = [1, 2.5, -3, 0x10, "s", true, false, nil]
+b = make([]int64, 1, 2)
+c = make(map[string]struct{ X int64 })
+d = make(chan int64, 1)
+make(type e, a)
+f, g = 1, 2
+h = a[1:2]
+i = a[:1:2]
+a[0], a[1] = a[1], a[0]
+a[0] += 1
+j = &b
+k = *j
+l = nil ?? 1
+n = -l + !true + ^1
+o = len(a) > 1 && 1 in a || 2 != 3
+p = a[0] << 1 | 3 & 5
+q = 1 - 2 * 3 / 4 % 5
+r = (1)
+s = func(x, y...) { return x, y }
+t = s(a...)
+u = a.b?? 1
+d <- 1
+v <- d
+w, x = <-d
+delete(c, "a")
+close(d)
+for {
+	break
+}
+switch a {
+case 1, 2:
+	fallthrough
+}
+func() { return }()
+go func() {}()
+try {
+	throw 1
+} catch {
+} finally {
+}
+try {
+	throw {"a": 1}
+} catch (e: int64) if e > 1 {
+} catch (e) {
+}
+a = @{"b": 1}
+var y: []int64, z = [1], 2
+const cc = 1
+dd = try typed(1, "b") + try ee.ff()
+func typed(a: int64, b, c: string...): (int64, error) { return a, nil }
+`
+
+func TestEncodeDecode(t *testing.T) {
+	for _, src := range []string{goodSrc, codecSrc, ""} {
+		stmt, err := parser.ParseSrc(src)
+		if err != nil {
+			t.Fatal("ParseSrc error:", err)
+		}
+
+		var buffer bytes.Buffer
+		err = Encode(&buffer, stmt)
+		if err != nil {
+			t.Fatal("Encode error:", err)
+		}
+		encoded := buffer.String()
+
+		decoded, err := Decode(&buffer)
+		if err != nil {
+			t.Fatal("Decode error:", err)
+		}
+
+		buffer.Reset()
+		err = Encode(&buffer, decoded)
+		if err != nil {
+			t.Fatal("Encode error:", err)
+		}
+		if buffer.String() != encoded {
+			t.Errorf("Encode - received: %v - expected: %v", buffer.String(), encoded)
+		}
+	}
+}
+
+func TestEncodeAllNodes(t *testing.T) {
+	found := make(map[string]struct{})
+	for _, src := range []string{goodSrc, codecSrc} {
+		stmt, err := parser.ParseSrc(src)
+		if err != nil {
+			t.Fatal("ParseSrc error:", err)
+		}
+		var buffer bytes.Buffer
+		err = Encode(&buffer, stmt)
+		if err != nil {
+			t.Fatal("Encode error:", err)
+		}
+		for name := range nodeTypes {
+			if strings.Contains(buffer.String(), `"node":"`+name+`"`) {
+				found[name] = struct{}{}
+			}
+		}
+	}
+
+	// CallExpr with Func and LetsExpr are only made by the VM and by hand
+	var missing []string
+	for name := range nodeTypes {
+		if _, ok := found[name]; !ok && name != "LetsExpr" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		t.Errorf("nodes not encoded: %v", missing)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: `{"format":"other","version":%v}`, err: `unknown format "other"`},
+		{input: `{"format":"anko","version":999}`, err: "unsupported format version 999, expected version %v"},
+		{input: `{"format":"anko","version":2,"stmt":{"node":"BreakStmt"}}`, err: "unsupported format version 2, expected version %v"},
+		{input: `{"format":"anko","version":%v,"stmt":{"node":"Foo"}}`, err: `unknown statement/expression "Foo"`},
+		{input: `{"format":"anko","version":%v,"stmt":{"node":"BreakStmt","pos":[1]}}`, err: "invalid position for BreakStmt"},
+		{input: `{"format":"anko","version":%v,"stmt":{"node":"BreakStmt","Label":"a"}}`, err: `BreakStmt: unknown field "Label"`},
+		{input: `{"format":"anko","version":%v,"stmt":{"node":"ExprStmt","Expr":{"node":"IdentExpr","Lit":"a","Raise":true}}}`, err: `ExprStmt.Expr: IdentExpr: unknown field "Raise"`},
+		{input: `{"format":"anko","version":%v,"stmt":{"node":"ExprStmt","Expr":{"node":"LiteralExpr","Literal":{"kind":"complex128","value":"1"}}}}`,
+			err: `ExprStmt.Expr: LiteralExpr.Literal: unknown literal kind "complex128"`},
+	}
+	for _, test := range tests {
+		test.input = strings.Replace(test.input, "%v", strconv.Itoa(FormatVersion), 1)
+		test.err = strings.Replace(test.err, "%v", strconv.Itoa(FormatVersion), 1)
+		_, err := Decode(strings.NewReader(test.input))
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Decode error - received: %v - expected: %v", err, test.err)
+		}
+	}
+}
+
+func TestEncodeFunc(t *testing.T) {
+	var stmt ast.Stmt = &ast.ExprStmt{Expr: &ast.CallExpr{Func: reflect.ValueOf(strings.ToLower)}}
+	err := Encode(&bytes.Buffer{}, stmt)
+	expected := "ExprStmt.Expr: CallExpr.Func: cannot encode literal of type func(string) string"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Encode error - received: %v - expected: %v", err, expected)
+	}
+}
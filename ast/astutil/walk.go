@@ -85,12 +85,19 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		if err := walkStmt(stmt.Try, f); err != nil {
 			return err
 		}
-		if err := walkStmt(stmt.Catch, f); err != nil {
+		if err := walkStmts(stmt.Catches, f); err != nil {
 			return err
 		}
 		if err := walkStmt(stmt.Finally, f); err != nil {
 			return err
 		}
+	case *ast.CatchStmt:
+		if err := walkExpr(stmt.Cond, f); err != nil {
+			return err
+		}
+		if err := walkStmt(stmt.Stmt, f); err != nil {
+			return err
+		}
 	case *ast.LoopStmt:
 		if err := walkExpr(stmt.Expr, f); err != nil {
 			return err
@@ -141,6 +148,21 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		}
 	case *ast.GoroutineStmt:
 		return walkExpr(stmt.Expr, f)
+	case *ast.DeleteStmt:
+		if err := walkExpr(stmt.Item, f); err != nil {
+			return err
+		}
+		return walkExpr(stmt.Key, f)
+	case *ast.CloseStmt:
+		return walkExpr(stmt.Expr, f)
+	case *ast.ChanStmt:
+		if err := walkExpr(stmt.RHS, f); err != nil {
+			return err
+		}
+		if err := walkExpr(stmt.LHS, f); err != nil {
+			return err
+		}
+		return walkExpr(stmt.OkExpr, f)
 	default:
 		return fmt.Errorf("unknown statement %v", reflect.TypeOf(stmt))
 	}
@@ -159,6 +181,7 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 	case *ast.OpExpr:
 		return walkOperator(expr.Op, f)
 	case *ast.LenExpr:
+		return walkExpr(expr.Expr, f)
 	case *ast.LiteralExpr:
 	case *ast.IdentExpr:
 	case *ast.MemberExpr:
@@ -234,6 +257,13 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 			return err
 		}
 		return walkExpr(expr.ListExpr, f)
+	case *ast.NilCoalescingOpExpr:
+		if err := walkExpr(expr.LHS, f); err != nil {
+			return err
+		}
+		return walkExpr(expr.RHS, f)
+	case *ast.MakeTypeExpr:
+		return walkExpr(expr.Type, f)
 	default:
 		return fmt.Errorf("unknown expression %v", reflect.TypeOf(expr))
 	}
@@ -237,3 +237,21 @@ func TestBadCode(t *testing.T) {
 		}
 	}
 }
+
+func TestWalkAllStatements(t *testing.T) {
+	stmts, err := parser.ParseSrc(codecSrc)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	var count int
+	err = Walk(stmts, func(e interface{}) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Walk error:", err)
+	}
+	if count == 0 {
+		t.Fatal("Walk did not call function")
+	}
+}
@@ -39,6 +39,7 @@ type MapExpr struct {
 	Keys     []Expr
 	Values   []Expr
 	TypeData *TypeStruct
+	Ordered  bool
 }
 
 // IdentExpr provide identity expression.
@@ -95,6 +96,7 @@ type CallExpr struct {
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Raise    bool // try call, a non-nil last error return value of a Go function is thrown
 }
 
 // AnonCallExpr provide anonymous calling expression. ex: func(){}().
@@ -104,6 +106,7 @@ type AnonCallExpr struct {
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Raise    bool // try call, a non-nil last error return value of a Go function is thrown
 }
 
 // MemberExpr provide expression to refer member.
@@ -132,10 +135,12 @@ type SliceExpr struct {
 // FuncExpr provide function expression.
 type FuncExpr struct {
 	ExprImpl
-	Name   string
-	Stmt   Stmt
-	Params []string
-	VarArg bool
+	Name        string
+	Stmt        Stmt
+	Params      []string
+	VarArg      bool
+	ParamTypes  []*TypeStruct // type annotations of Params, nil without annotations, element type for VarArg
+	ReturnTypes []*TypeStruct // type annotations of the return values
 }
 
 // LetsExpr provide multiple expression of let.
@@ -179,7 +184,7 @@ type LenExpr struct {
 	Expr Expr
 }
 
-// IncludeExpr provide in expression
+// IncludeExpr provide in expression, an item of a slice or array, a key of a map, a substring or an item of a vm.Container
 type IncludeExpr struct {
 	ExprImpl
 	ItemExpr Expr
@@ -35,11 +35,20 @@ type IfStmt struct {
 type TryStmt struct {
 	StmtImpl
 	Try     Stmt
-	Var     string
-	Catch   Stmt
+	Catches []Stmt
 	Finally Stmt
 }
 
+// CatchStmt provide "catch" clause of try statement.
+// A clause with TypeData only catches thrown values of the type and a clause with Cond only when it is true.
+type CatchStmt struct {
+	StmtImpl
+	Var      string
+	TypeData *TypeStruct
+	Cond     Expr
+	Stmt     Stmt
+}
+
 // ForStmt provide "for in" expression statement.
 type ForStmt struct {
 	StmtImpl
@@ -112,7 +121,9 @@ type SwitchCaseStmt struct {
 type VarStmt struct {
 	StmtImpl
 	Names []string
+	Types []*TypeStruct // type annotations of Names, nil without annotations
 	Exprs []Expr
+	Const bool // declared with const, the names can not be assigned to or deleted
 }
 
 // LetsStmt provide multiple statement of let.
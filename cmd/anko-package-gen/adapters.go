@@ -0,0 +1,227 @@
+//go:build go1.22
+// +build go1.22
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const envPath = "github.com/mattn/anko/env"
+
+// adaptable returns true if an adapter of iface can be generated outside of pkg:
+// the interface is not a constraint and its methods and the types they use are exported
+func adaptable(pkg *types.Package, iface *types.Interface) bool {
+	if !iface.IsMethodSet() || iface.NumMethods() < 1 {
+		return false
+	}
+	names := make(map[string]bool, iface.NumMethods())
+	for i := 0; i < iface.NumMethods(); i++ {
+		method := iface.Method(i)
+		if !method.Exported() || !importableType(method.Type()) {
+			return false
+		}
+		names[method.Name()] = true
+	}
+	for name := range names {
+		if names[adapterField(name)] {
+			// the func field would have the name of a method
+			return false
+		}
+	}
+	return true
+}
+
+// importableType returns true if the type can be written in another package
+func importableType(t types.Type) bool {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		return t.Kind() != types.UnsafePointer && t.Info()&types.IsUntyped == 0
+	case *types.Named:
+		object := t.Obj()
+		if object.Pkg() == nil {
+			// error
+			return true
+		}
+		if !object.Exported() || !importablePath(object.Pkg().Path()) {
+			return false
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if !importableType(t.TypeArgs().At(i)) {
+				return false
+			}
+		}
+		return true
+	case *types.Pointer:
+		return importableType(t.Elem())
+	case *types.Slice:
+		return importableType(t.Elem())
+	case *types.Array:
+		return importableType(t.Elem())
+	case *types.Chan:
+		return importableType(t.Elem())
+	case *types.Map:
+		return importableType(t.Key()) && importableType(t.Elem())
+	case *types.Signature:
+		if t.TypeParams().Len() > 0 {
+			return false
+		}
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if !importableType(tuple.At(i).Type()) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !t.Field(i).Exported() || !importableType(t.Field(i).Type()) {
+				return false
+			}
+		}
+		return true
+	case *types.Interface:
+		if !t.IsMethodSet() {
+			return false
+		}
+		for i := 0; i < t.NumMethods(); i++ {
+			if !t.Method(i).Exported() || !importableType(t.Method(i).Type()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// importablePath returns false for internal and vendored packages
+func importablePath(path string) bool {
+	for _, part := range strings.Split(path, "/") {
+		if part == "internal" || part == "vendor" {
+			return false
+		}
+	}
+	return true
+}
+
+// adapterField returns the name of the func field of a method
+func adapterField(method string) string {
+	return method + "Func"
+}
+
+// adapterSource returns the formatted file of the interface adapters from version minVersion and older,
+// or nil if there are none
+func (bindings *packageBindings) adapterSource(packageName string, minVersion int) ([]byte, error) {
+	var adapters []adapter
+	for _, adapter := range bindings.adapters {
+		if adapter.version <= minVersion {
+			adapters = append(adapters, adapter)
+		}
+	}
+	if len(adapters) == 0 {
+		return nil, nil
+	}
+
+	// the names of the imported packages by path and the paths by name
+	imports := map[string]string{bindings.path: bindings.name, "reflect": "reflect", envPath: "env"}
+	paths := map[string]string{bindings.name: bindings.path, "reflect": "reflect", "env": envPath}
+	qualifier := func(pkg *types.Package) string {
+		if name, ok := imports[pkg.Path()]; ok {
+			return name
+		}
+		name := pkg.Name()
+		for i := 2; paths[name] != ""; i++ {
+			name = pkg.Name() + strconv.Itoa(i)
+		}
+		imports[pkg.Path()] = name
+		paths[name] = pkg.Path()
+		return name
+	}
+
+	var body bytes.Buffer
+	for _, adapter := range adapters {
+		typeName := bindings.identifier() + adapter.name + "Adapter"
+		fmt.Fprintf(&body, "// %s is the adapter of %s.%s\n", typeName, bindings.name, adapter.name)
+		fmt.Fprintf(&body, "type %s struct {\n", typeName)
+		var methods bytes.Buffer
+		for i := 0; i < adapter.iface.NumMethods(); i++ {
+			method := adapter.iface.Method(i)
+			params, args, results := adapterSignature(method.Type().(*types.Signature), qualifier)
+			field := adapterField(method.Name())
+			fmt.Fprintf(&body, "\t%s func(%s)%s `anko:%q`\n", field, params, results, method.Name())
+
+			fmt.Fprintf(&methods, "\n// %s calls %s\n", method.Name(), field)
+			fmt.Fprintf(&methods, "func (adapter *%s) %s(%s)%s {\n\t", typeName, method.Name(), params, results)
+			if results != "" {
+				methods.WriteString("return ")
+			}
+			fmt.Fprintf(&methods, "adapter.%s(%s)\n}\n", field, args)
+		}
+		body.WriteString("}\n")
+		body.Write(methods.Bytes())
+		body.WriteString("\n")
+	}
+
+	body.WriteString("func init() {\n")
+	for _, adapter := range adapters {
+		fmt.Fprintf(&body, "\tenv.InterfaceAdapters[reflect.TypeOf((*%s.%s)(nil)).Elem()] = reflect.TypeOf(%s%sAdapter{})\n",
+			bindings.name, adapter.name, bindings.identifier(), adapter.name)
+	}
+	body.WriteString("}\n")
+
+	var buffer bytes.Buffer
+	buffer.WriteString(generatedHeader + "\npackage " + packageName + "\n\nimport (\n")
+	var standard, other []string
+	for path, name := range imports {
+		line := strconv.Quote(path)
+		if name != filepath.Base(path) {
+			line = name + " " + line
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, line)
+		} else {
+			standard = append(standard, line)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(other)
+	buffer.WriteString("\t" + strings.Join(standard, "\n\t") + "\n\n\t" + strings.Join(other, "\n\t") + "\n)\n\n")
+	buffer.Write(body.Bytes())
+	return format.Source(buffer.Bytes())
+}
+
+// adapterSignature returns the parameters, the arguments to pass them on and the results of a method
+func adapterSignature(signature *types.Signature, qualifier types.Qualifier) (string, string, string) {
+	params := make([]string, signature.Params().Len())
+	args := make([]string, signature.Params().Len())
+	for i := range params {
+		args[i] = "a" + strconv.Itoa(i)
+		paramType := signature.Params().At(i).Type()
+		if signature.Variadic() && i == len(params)-1 {
+			params[i] = args[i] + " ..." + types.TypeString(paramType.(*types.Slice).Elem(), qualifier)
+			args[i] += "..."
+			continue
+		}
+		params[i] = args[i] + " " + types.TypeString(paramType, qualifier)
+	}
+
+	results := make([]string, signature.Results().Len())
+	for i := range results {
+		results[i] = types.TypeString(signature.Results().At(i).Type(), qualifier)
+	}
+	switch len(results) {
+	case 0:
+		return strings.Join(params, ", "), strings.Join(args, ", "), ""
+	case 1:
+		return strings.Join(params, ", "), strings.Join(args, ", "), " " + results[0]
+	}
+	return strings.Join(params, ", "), strings.Join(args, ", "), " (" + strings.Join(results, ", ") + ")"
+}
@@ -1,122 +1,578 @@
+//go:build go1.22
+// +build go1.22
+
+// Command anko-package-gen generates the anko bindings of Go packages:
+// the functions, variables and constants in env.Packages and the types in env.PackageTypes.
+//
+// Usage:
+//
+//	anko-package-gen [-o dir] [-min go1.N] [-package name] [-all] [-adapters] [import paths]
+//
+// Packages are loaded with type checking, from the standard library or from the modules of the current module.
+// Without -o the bindings of each package are printed.
+// With -o the bindings are written in the directory, one file per package. Standard library symbols that were added
+// after the -min Go version, by default the go version of the go.mod of the directory, are written in files with
+// go1.N build tags, found by comparing the API files of GOROOT/api.
+// The files of a package, its base file like net.http.go and its version files like net.httpGo118.go, are replaced.
+// With -all the packages already bound in the -o directory are regenerated too.
+//
+// With -adapters the interface adapters of the interfaces of a package are generated too, in a file like ioAdapters.go,
+// and added to env.InterfaceAdapters so the VM can convert script maps and modules of functions to the interfaces.
+// Packages with an adapters file in the -o directory always get their adapters regenerated.
+//
+// The bindings files of the packages listed with -keep are maintained by hand and are not written,
+// only their adapters are.
+//
+// Values and types written by hand for a package are added to env.PackageExtraValues and env.PackageExtraTypes,
+// which the generated files never set, so they are kept when the bindings are regenerated.
+//
+// anko-package-gen needs Go 1.22 or newer, for the type checked loading of generic packages and type aliases.
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"flag"
 	"fmt"
-	"go/ast"
-	"go/parser"
+	"go/constant"
+	"go/format"
+	"go/importer"
 	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
 	"log"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 )
 
-func pkgName(f string) string {
-	file, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.PackageClauseOnly)
-	if err != nil || file == nil {
-		return ""
+const generatedHeader = "// Code generated by anko-package-gen. DO NOT EDIT.\n"
+
+type (
+	// binding is a generated value or type of a package
+	binding struct {
+		name    string
+		code    string
+		isType  bool
+		version int
+	}
+
+	// adapter is a generated interface adapter of a package
+	adapter struct {
+		name    string
+		iface   *types.Interface
+		version int
+	}
+
+	// packageBindings are the bindings of a package
+	packageBindings struct {
+		path     string
+		name     string
+		bindings []binding
+		adapters []adapter
+	}
+
+	// apiVersions are the Go 1 minor versions that added the symbols of the standard library packages
+	apiVersions map[string]map[string]int
+)
+
+var (
+	flagOutput   = flag.String("o", "", "write the bindings in the named directory instead of printing them")
+	flagMin      = flag.String("min", "", "oldest Go version of the bindings, like go1.13, by default the go version of the go.mod of the -o directory")
+	flagPackage  = flag.String("package", "packages", "package name of the generated files")
+	flagAll      = flag.Bool("all", false, "also regenerate the packages already bound in the -o directory")
+	flagAdapters = flag.Bool("adapters", false, "also generate the interface adapters of the packages")
+	flagKeep     = flag.String("keep", "", "comma separated import paths of the packages with bindings files maintained by hand, which are not written")
+
+	apiLineRegexp      = regexp.MustCompile(`^pkg ([^ ,]+)(?: \([^)]*\))?, (?:func|type|const|var) ([A-Za-z0-9_]+)`)
+	boundPackageRegexp = regexp.MustCompile(`env\.Packages\["([^"]+)"\] = `)
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("anko-package-gen: ")
+	flag.Parse()
+
+	paths := flag.Args()
+	if *flagAll {
+		if *flagOutput == "" {
+			log.Fatal("-all needs -o")
+		}
+		boundPaths, err := findBoundPackages(*flagOutput)
+		if err != nil {
+			log.Fatal(err)
+		}
+		paths = append(paths, boundPaths...)
+	}
+	paths = uniqueStrings(paths)
+	if len(paths) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	minVersion := 0
+	var versions apiVersions
+	if *flagOutput != "" {
+		var err error
+		minVersion, err = findMinVersion(*flagMin, *flagOutput)
+		if err != nil {
+			log.Fatal(err)
+		}
+		versions, err = readAPIVersions()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	keep := make(map[string]bool)
+	for _, path := range strings.Split(*flagKeep, ",") {
+		if path != "" {
+			keep[path] = true
+		}
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	imp := importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+
+	for _, path := range paths {
+		pkg, err := imp.ImportFrom(path, workDir, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		bindings := newPackageBindings(pkg, versions[path])
+
+		if *flagOutput == "" {
+			source, err := bindings.source(*flagPackage, 0)
+			if err != nil {
+				log.Fatal(err)
+			}
+			os.Stdout.Write(source)
+			if !*flagAdapters {
+				continue
+			}
+			source, err = bindings.adapterSource(*flagPackage, 0)
+			if err != nil {
+				log.Fatal(err)
+			}
+			os.Stdout.Write(source)
+			continue
+		}
+
+		err = bindings.writeFiles(*flagOutput, *flagPackage, minVersion, *flagAdapters, keep[path])
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
-	return file.Name.Name
 }
 
-func isGoFile(dir os.FileInfo) bool {
-	return !dir.IsDir() &&
-		!strings.HasPrefix(dir.Name(), ".") && // ignore .files
-		filepath.Ext(dir.Name()) == ".go"
+// newPackageBindings makes the bindings of the exported symbols of pkg.
+// Generic functions and types are left out because they can not be used without instantiation.
+func newPackageBindings(pkg *types.Package, versions map[string]int) *packageBindings {
+	bindings := &packageBindings{path: pkg.Path(), name: pkg.Name()}
+	if bindings.name == "env" || bindings.name == "reflect" && bindings.path != "reflect" {
+		bindings.name = "pkg" + strings.Title(bindings.name)
+	}
+
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		object := scope.Lookup(name)
+		if !object.Exported() {
+			continue
+		}
+		qualified := bindings.name + "." + name
+		var code string
+		isType := false
+		switch object := object.(type) {
+		case *types.Func:
+			if object.Type().(*types.Signature).TypeParams().Len() > 0 {
+				continue
+			}
+			code = "reflect.ValueOf(" + qualified + ")"
+		case *types.Var:
+			code = "reflect.ValueOf(" + qualified + ")"
+		case *types.Const:
+			constant, ok := constantCode(qualified, object)
+			if !ok {
+				continue
+			}
+			code = "reflect.ValueOf(" + constant + ")"
+		case *types.TypeName:
+			if named, ok := object.Type().(*types.Named); ok && named.TypeParams().Len() > 0 && !object.IsAlias() {
+				continue
+			}
+			if alias, ok := object.Type().(*types.Alias); ok && alias.TypeParams().Len() > 0 {
+				continue
+			}
+			code = "reflect.TypeOf((*" + qualified + ")(nil)).Elem()"
+			isType = true
+			if iface, ok := object.Type().Underlying().(*types.Interface); ok && !object.IsAlias() && adaptable(pkg, iface) {
+				bindings.adapters = append(bindings.adapters, adapter{name: name, iface: iface, version: versions[name]})
+			}
+		default:
+			continue
+		}
+		bindings.bindings = append(bindings.bindings, binding{name: name, code: code, isType: isType, version: versions[name]})
+	}
+	return bindings
 }
 
-func isPkgFile(dir os.FileInfo) bool {
-	return isGoFile(dir) && !strings.HasSuffix(dir.Name(), "_test.go") // ignore test files
+// constantCode returns the code of a constant, untyped constants get the type of anko values that can hold them.
+// Returns false for constants that do not fit in any of them, like big.MaxPrec on 32 bit or math.MaxFloat64 * 2.
+func constantCode(qualified string, object *types.Const) (string, bool) {
+	basic, ok := object.Type().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped == 0 {
+		return qualified, true
+	}
+	value := object.Val()
+	switch value.Kind() {
+	case constant.Int:
+		if _, exact := constant.Int64Val(value); exact {
+			if basic.Kind() == types.UntypedRune {
+				return qualified, true
+			}
+			return "int64(" + qualified + ")", true
+		}
+		if _, exact := constant.Uint64Val(value); exact {
+			return "uint64(" + qualified + ")", true
+		}
+		return "", false
+	case constant.Float:
+		if f, _ := constant.Float64Val(value); math.IsInf(f, 0) {
+			return "", false
+		}
+		return "float64(" + qualified + ")", true
+	}
+	return qualified, true
 }
 
-func parseDir(p string) (map[string]*ast.Package, error) {
-	isGoFile := func(d os.FileInfo) bool {
-		return !d.IsDir() && !strings.HasSuffix(d.Name(), "_test.go") && !strings.HasPrefix(d.Name(), "example_")
+// source returns the formatted file of the bindings from version minVersion and older
+func (bindings *packageBindings) source(packageName string, minVersion int) ([]byte, error) {
+	var valueBindings, typeBindings []binding
+	for _, binding := range bindings.bindings {
+		if binding.version > minVersion {
+			continue
+		}
+		if binding.isType {
+			typeBindings = append(typeBindings, binding)
+		} else {
+			valueBindings = append(valueBindings, binding)
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(generatedHeader + "\npackage " + packageName + "\n\n")
+	bindings.writeImports(&buffer, len(valueBindings)+len(typeBindings) > 0)
+	buffer.WriteString("func init() {\n")
+	fmt.Fprintf(&buffer, "\tenv.Packages[%q] = map[string]reflect.Value{\n", bindings.path)
+	for _, binding := range valueBindings {
+		fmt.Fprintf(&buffer, "\t\t%q: %s,\n", binding.name, binding.code)
+	}
+	buffer.WriteString("\t}\n")
+	fmt.Fprintf(&buffer, "\tenv.PackageTypes[%q] = map[string]reflect.Type{\n", bindings.path)
+	for _, binding := range typeBindings {
+		fmt.Fprintf(&buffer, "\t\t%q: %s,\n", binding.name, binding.code)
+	}
+	buffer.WriteString("\t}\n")
+	for _, version := range bindings.versions(minVersion) {
+		fmt.Fprintf(&buffer, "\t%s()\n", bindings.versionFunc(version))
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+// versionSource returns the formatted file of the bindings added in version, or the empty function when not added is true
+func (bindings *packageBindings) versionSource(packageName string, version int, notAdded bool) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString(generatedHeader + "\n")
+	tag := "go1." + strconv.Itoa(version)
+	if notAdded {
+		tag = "!" + tag
+	}
+	fmt.Fprintf(&buffer, "//go:build %s\n// +build %s\n\npackage %s\n\n", tag, tag, packageName)
+
+	if notAdded {
+		fmt.Fprintf(&buffer, "func %s() {}\n", bindings.versionFunc(version))
+		return format.Source(buffer.Bytes())
 	}
 
-	pkgs, err := parser.ParseDir(token.NewFileSet(), p, isGoFile, parser.ParseComments)
+	bindings.writeImports(&buffer, true)
+	fmt.Fprintf(&buffer, "func %s() {\n", bindings.versionFunc(version))
+	for _, binding := range bindings.bindings {
+		if binding.version != version {
+			continue
+		}
+		if binding.isType {
+			fmt.Fprintf(&buffer, "\tenv.PackageTypes[%q][%q] = %s\n", bindings.path, binding.name, binding.code)
+		} else {
+			fmt.Fprintf(&buffer, "\tenv.Packages[%q][%q] = %s\n", bindings.path, binding.name, binding.code)
+		}
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+// writeImports writes the imports of a bindings file, the bound package only if usesPackage is true
+func (bindings *packageBindings) writeImports(buffer *bytes.Buffer, usesPackage bool) {
+	buffer.WriteString("import (\n\t\"reflect\"\n")
+	if usesPackage && bindings.path != "reflect" {
+		if bindings.name != filepath.Base(bindings.path) {
+			fmt.Fprintf(buffer, "\t%s %q\n", bindings.name, bindings.path)
+		} else {
+			fmt.Fprintf(buffer, "\t%q\n", bindings.path)
+		}
+	}
+	buffer.WriteString("\n\t\"github.com/mattn/anko/env\"\n)\n\n")
+}
+
+// versions returns the sorted versions newer than minVersion that added bindings
+func (bindings *packageBindings) versions(minVersion int) []int {
+	found := make(map[int]bool)
+	var versions []int
+	for _, binding := range bindings.bindings {
+		if binding.version > minVersion && !found[binding.version] {
+			found[binding.version] = true
+			versions = append(versions, binding.version)
+		}
+	}
+	sort.Ints(versions)
+	return versions
+}
+
+// fileBase returns the base name of the files of the bindings, like net.http for net/http
+func (bindings *packageBindings) fileBase() string {
+	return strings.Replace(bindings.path, "/", ".", -1)
+}
+
+// versionFunc returns the name of the function that adds the bindings of a version, like netHttpGo118
+func (bindings *packageBindings) versionFunc(version int) string {
+	return bindings.identifier() + "Go1" + strconv.Itoa(version)
+}
+
+// identifier returns the path as an unexported identifier, like netHttp for net/http
+func (bindings *packageBindings) identifier() string {
+	parts := strings.FieldsFunc(bindings.path, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.Title(parts[i])
+	}
+	return strings.Join(parts, "")
+}
+
+// writeFiles writes the bindings files in dir, replacing the version files of the package, like netGo121.go and netNotGo121.go.
+// The bindings files are not written if keep is true, because they are maintained by hand.
+// The adapters file is written if adapters is true or it exists.
+func (bindings *packageBindings) writeFiles(dir string, packageName string, minVersion int, adapters bool, keep bool) error {
+	if !keep {
+		err := bindings.writeBindingFiles(dir, packageName, minVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	adapterFile := filepath.Join(dir, bindings.fileBase()+"Adapters.go")
+	if _, err := os.Stat(adapterFile); err == nil {
+		adapters = true
+	}
+	if !adapters {
+		return nil
+	}
+	source, err := bindings.adapterSource(packageName, minVersion)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("%v: %v", bindings.path, err)
+	}
+	if source == nil {
+		err = os.Remove(adapterFile)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
 	}
-	return pkgs, nil
+	return ioutil.WriteFile(adapterFile, source, 0644)
 }
 
-func main() {
-	pkg := "flag"
-	if len(os.Args) == 2 {
-		pkg = os.Args[1]
+// writeBindingFiles writes the base file and the version files of the bindings in dir, replacing the old version files
+func (bindings *packageBindings) writeBindingFiles(dir string, packageName string, minVersion int) error {
+	base := bindings.fileBase()
+	oldFiles, err := filepath.Glob(filepath.Join(dir, base+"*Go1*.go"))
+	if err != nil {
+		return err
+	}
+	for _, oldFile := range oldFiles {
+		if !versionFileRegexp(base).MatchString(filepath.Base(oldFile)) {
+			continue
+		}
+		err = os.Remove(oldFile)
+		if err != nil {
+			return err
+		}
 	}
-	b, err := exec.Command("go", "env", "GOROOT").CombinedOutput()
+
+	source, err := bindings.source(packageName, minVersion)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("%v: %v", bindings.path, err)
 	}
-	paths := []string{filepath.Join(strings.TrimSpace(string(b)), "src")}
-	b, err = exec.Command("go", "env", "GOPATH").CombinedOutput()
+	err = ioutil.WriteFile(filepath.Join(dir, base+".go"), source, 0644)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	for _, p := range strings.Split(strings.TrimSpace(string(b)), string(filepath.ListSeparator)) {
-		paths = append(paths, filepath.Join(p, "src"))
+
+	for _, version := range bindings.versions(minVersion) {
+		for _, notAdded := range []bool{false, true} {
+			source, err = bindings.versionSource(packageName, version, notAdded)
+			if err != nil {
+				return fmt.Errorf("%v: %v", bindings.path, err)
+			}
+			name := base + "Go1" + strconv.Itoa(version) + ".go"
+			if notAdded {
+				name = base + "NotGo1" + strconv.Itoa(version) + ".go"
+			}
+			err = ioutil.WriteFile(filepath.Join(dir, name), source, 0644)
+			if err != nil {
+				return err
+			}
+		}
 	}
-	for _, p := range paths {
-		pp := filepath.Join(p, pkg)
-		pkgs, err := parseDir(pp)
-		if err != nil || len(pkgs) == 0 {
-			continue
+	return nil
+}
+
+// versionFileRegexp matches the names of the version files of base
+func versionFileRegexp(base string) *regexp.Regexp {
+	return regexp.MustCompile(`^` + regexp.QuoteMeta(base) + `(Not)?Go1[0-9]+\.go$`)
+}
+
+// findBoundPackages returns the import paths of the packages bound in the files of dir
+func findBoundPackages(dir string) ([]string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, filename := range filenames {
+		source, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
 		}
-		names := map[string]bool{}
-		pn := pkg
-		for _, pp := range pkgs {
-			pn = pp.Name
-			for _, f := range pp.Files {
-				for _, d := range f.Decls {
-					switch decl := d.(type) {
-					case *ast.GenDecl:
-						for _, spec := range decl.Specs {
-							if vspec, ok := spec.(*ast.ValueSpec); ok {
-								for _, n := range vspec.Names {
-									c := n.Name[0]
-									if c < 'A' || c > 'Z' {
-										continue
-									}
-									names[n.Name] = true
-								}
-							}
-						}
-					case *ast.FuncDecl:
-						if decl.Recv != nil {
-							continue
-						}
-						c := decl.Name.Name[0]
-						if c < 'A' || c > 'Z' {
-							continue
-						}
-						names[decl.Name.Name] = true
-					}
+		for _, match := range boundPackageRegexp.FindAllSubmatch(source, -1) {
+			paths = append(paths, string(match[1]))
+		}
+	}
+	return paths, nil
+}
+
+// findMinVersion returns the Go 1 minor version of flagMin or of the go directive of the go.mod of dir
+func findMinVersion(flagMin string, dir string) (int, error) {
+	if flagMin != "" {
+		return parseGoVersion(flagMin)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		source, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(source), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 2 && fields[0] == "go" {
+					return parseGoVersion(fields[1])
 				}
 			}
+			return 0, nil
 		}
-		keys := []string{}
-		for k := range names {
-			keys = append(keys, k)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return 0, nil
 		}
-		sort.Strings(keys)
-		fmt.Printf(`// Package %s implements %s interface for anko script.
-package %s
+		dir = parent
+	}
+}
 
-import (
-	"%s"
-)
+// parseGoVersion returns the minor version of a Go version like go1.13 or 1.13.2
+func parseGoVersion(version string) (int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "go"), ".")
+	if len(parts) < 2 || parts[0] != "1" {
+		return 0, fmt.Errorf("invalid Go version: %v", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid Go version: %v", version)
+	}
+	return minor, nil
+}
+
+// readAPIVersions reads the API files of GOROOT/api
+func readAPIVersions() (apiVersions, error) {
+	output, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return nil, err
+	}
+	filenames, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(output)), "api", "go1*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(apiVersions)
+	for _, filename := range filenames {
+		version := 0
+		name := strings.TrimSuffix(filepath.Base(filename), ".txt")
+		if name != "go1" {
+			version, err = parseGoVersion(name)
+			if err != nil {
+				return nil, err
+			}
+		}
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		err = versions.read(file, version)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// read reads an API file of version, keeping the oldest version of each symbol
+func (versions apiVersions) read(reader io.Reader, version int) error {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		match := apiLineRegexp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		symbols, ok := versions[match[1]]
+		if !ok {
+			symbols = make(map[string]int)
+			versions[match[1]] = symbols
+		}
+		if oldVersion, ok := symbols[match[2]]; !ok || version < oldVersion {
+			symbols[match[2]] = version
+		}
+	}
+	return scanner.Err()
+}
 
-func init() {
-	Packages["%s"] = map[string]interface{}{
-`, pn, pkg, pn, pkg, pn)
-		for _, k := range keys {
-			fmt.Printf(`	"%s": %s.%s,`+"\n", k, pn, k)
+// uniqueStrings returns values without duplicates, keeping the order
+func uniqueStrings(values []string) []string {
+	found := make(map[string]bool, len(values))
+	unique := values[:0]
+	for _, value := range values {
+		if !found[value] {
+			found[value] = true
+			unique = append(unique, value)
 		}
-		fmt.Println(`	}
-}`)
 	}
+	return unique
 }
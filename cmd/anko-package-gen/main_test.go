@@ -0,0 +1,295 @@
+//go:build go1.22
+// +build go1.22
+
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseGoVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected int
+		err      bool
+	}{
+		{version: "go1.13", expected: 13},
+		{version: "1.18", expected: 18},
+		{version: "1.21.3", expected: 21},
+		{version: "go2.0", err: true},
+		{version: "go1", err: true},
+	}
+	for _, test := range tests {
+		minor, err := parseGoVersion(test.version)
+		if (err != nil) != test.err || minor != test.expected {
+			t.Errorf("parseGoVersion %v - received: %v %v - expected: %v %v", test.version, minor, err, test.expected, test.err)
+		}
+	}
+}
+
+func TestAPIVersionsRead(t *testing.T) {
+	versions := make(apiVersions)
+	err := versions.read(strings.NewReader(`pkg strings, func Cut(string, string) (string, string, bool)
+pkg strings, method (*Builder) Cap() int
+pkg syscall (linux-386), const AF_ALG = 38
+pkg strings, type Builder struct
+`), 18)
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+	err = versions.read(strings.NewReader("pkg strings, type Builder struct\n"), 10)
+	if err != nil {
+		t.Fatal("read error:", err)
+	}
+
+	expected := apiVersions{
+		"strings": {"Cut": 18, "Builder": 10},
+		"syscall": {"AF_ALG": 18},
+	}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Errorf("versions - received: %v - expected: %v", versions, expected)
+	}
+}
+
+func TestPackageBindings(t *testing.T) {
+	source := `package sample
+
+const (
+	Small = 1
+	Big = 1 << 63
+	Huge = 1 << 64
+	Pi = 3.14
+	Letter = 'a'
+	Typed int32 = 2
+)
+
+var Value = 1
+
+type Thing struct{}
+
+type List[T any] []T
+
+func Do() {}
+
+func Map[T any](t T) T { return t }
+
+func unexported() {}
+`
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "sample.go", source, 0)
+	if err != nil {
+		t.Fatal("ParseFile error:", err)
+	}
+	pkg, err := new(types.Config).Check("example.com/some/sample", fileSet, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal("Check error:", err)
+	}
+
+	bindings := newPackageBindings(pkg, map[string]int{"Do": 18})
+	codes := make(map[string]string)
+	for _, binding := range bindings.bindings {
+		codes[binding.name] = binding.code
+	}
+	expected := map[string]string{
+		"Big":    "reflect.ValueOf(uint64(sample.Big))",
+		"Do":     "reflect.ValueOf(sample.Do)",
+		"Letter": "reflect.ValueOf(sample.Letter)",
+		"Pi":     "reflect.ValueOf(float64(sample.Pi))",
+		"Small":  "reflect.ValueOf(int64(sample.Small))",
+		"Thing":  "reflect.TypeOf((*sample.Thing)(nil)).Elem()",
+		"Typed":  "reflect.ValueOf(sample.Typed)",
+		"Value":  "reflect.ValueOf(sample.Value)",
+	}
+	if !reflect.DeepEqual(codes, expected) {
+		t.Errorf("bindings - received: %v - expected: %v", codes, expected)
+	}
+
+	if bindings.fileBase() != "example.com.some.sample" || bindings.versionFunc(18) != "exampleComSomeSampleGo118" {
+		t.Errorf("names - received: %v %v", bindings.fileBase(), bindings.versionFunc(18))
+	}
+
+	output, err := bindings.source("packages", 13)
+	if err != nil {
+		t.Fatal("source error:", err)
+	}
+	for _, line := range []string{`	"example.com/some/sample"`, `		"Small":  reflect.ValueOf(int64(sample.Small)),`, `		"Thing": reflect.TypeOf((*sample.Thing)(nil)).Elem(),`, `	exampleComSomeSampleGo118()`} {
+		if !strings.Contains(string(output), line+"\n") {
+			t.Errorf("source - received:\n%s\nexpected line: %v", output, line)
+		}
+	}
+	if strings.Contains(string(output), `"Do"`) {
+		t.Errorf("source - received:\n%s\nexpected no Do binding", output)
+	}
+
+	output, err = bindings.versionSource("packages", 18, false)
+	if err != nil {
+		t.Fatal("versionSource error:", err)
+	}
+	for _, line := range []string{"//go:build go1.18", "// +build go1.18", `	env.Packages["example.com/some/sample"]["Do"] = reflect.ValueOf(sample.Do)`} {
+		if !strings.Contains(string(output), line+"\n") {
+			t.Errorf("versionSource - received:\n%s\nexpected line: %v", output, line)
+		}
+	}
+
+	output, err = bindings.versionSource("packages", 18, true)
+	if err != nil {
+		t.Fatal("versionSource error:", err)
+	}
+	if !strings.Contains(string(output), "//go:build !go1.18\n") || !strings.HasSuffix(string(output), "func exampleComSomeSampleGo118() {}\n") {
+		t.Errorf("versionSource not added - received:\n%s", output)
+	}
+}
+
+func TestAdapterSource(t *testing.T) {
+	source := `package sample
+
+import "io"
+
+type Handler interface {
+	Handle(r io.Reader, names ...string) (int, error)
+	Close()
+}
+
+type New interface {
+	Get() string
+}
+
+type Hidden interface {
+	hidden()
+}
+
+type Private interface {
+	Get() private
+}
+
+type private int
+
+type Number interface {
+	~int | ~float64
+}
+`
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "sample.go", source, 0)
+	if err != nil {
+		t.Fatal("ParseFile error:", err)
+	}
+	config := &types.Config{Importer: importer.ForCompiler(fileSet, "source", nil)}
+	pkg, err := config.Check("example.com/sample", fileSet, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal("Check error:", err)
+	}
+
+	bindings := newPackageBindings(pkg, map[string]int{"New": 18})
+	var names []string
+	for _, adapter := range bindings.adapters {
+		names = append(names, adapter.name)
+	}
+	if !reflect.DeepEqual(names, []string{"Handler", "New"}) {
+		t.Errorf("adapters - received: %v - expected: %v", names, []string{"Handler", "New"})
+	}
+
+	output, err := bindings.adapterSource("packages", 13)
+	if err != nil {
+		t.Fatal("adapterSource error:", err)
+	}
+	for _, line := range []string{
+		`	"io"`,
+		`	"example.com/sample"`,
+		"	HandleFunc func(a0 io.Reader, a1 ...string) (int, error) `anko:\"Handle\"`",
+		`func (adapter *exampleComSampleHandlerAdapter) Handle(a0 io.Reader, a1 ...string) (int, error) {`,
+		`	return adapter.HandleFunc(a0, a1...)`,
+		`	adapter.CloseFunc()`,
+		`	env.InterfaceAdapters[reflect.TypeOf((*sample.Handler)(nil)).Elem()] = reflect.TypeOf(exampleComSampleHandlerAdapter{})`,
+	} {
+		if !strings.Contains(string(output), line+"\n") {
+			t.Errorf("adapterSource - received:\n%s\nexpected line: %v", output, line)
+		}
+	}
+	if strings.Contains(string(output), "NewAdapter") {
+		t.Errorf("adapterSource - received:\n%s\nexpected no New adapter", output)
+	}
+
+	output, err = bindings.adapterSource("packages", 18)
+	if err != nil {
+		t.Fatal("adapterSource error:", err)
+	}
+	if !strings.Contains(string(output), "NewAdapter") {
+		t.Errorf("adapterSource - received:\n%s\nexpected New adapter", output)
+	}
+
+	bindings.adapters = nil
+	output, err = bindings.adapterSource("packages", 18)
+	if err != nil || output != nil {
+		t.Errorf("adapterSource no adapters - received: %s, %v - expected: %v, %v", output, err, nil, nil)
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anko-package-gen")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "sample.go", "package sample\n\nfunc Do() {}\n\nfunc New() {}\n", 0)
+	if err != nil {
+		t.Fatal("ParseFile error:", err)
+	}
+	pkg, err := new(types.Config).Check("sample", fileSet, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal("Check error:", err)
+	}
+	bindings := newPackageBindings(pkg, map[string]int{"New": 18})
+
+	handSource := "package packages\n"
+	for _, name := range []string{"sample.go", "sampleGo110.go", "sampleExtra.go"} {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(handSource), 0644)
+		if err != nil {
+			t.Fatal("WriteFile error:", err)
+		}
+	}
+
+	// kept bindings files are not written
+	err = bindings.writeFiles(dir, "packages", 13, false, true)
+	if err != nil {
+		t.Fatal("writeFiles error:", err)
+	}
+	for _, name := range []string{"sample.go", "sampleGo110.go"} {
+		source, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(source) != handSource {
+			t.Errorf("keep %v - received: %q, %v - expected: %q, %v", name, source, err, handSource, nil)
+		}
+	}
+
+	err = bindings.writeFiles(dir, "packages", 13, false, false)
+	if err != nil {
+		t.Fatal("writeFiles error:", err)
+	}
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal("Glob error:", err)
+	}
+	var names []string
+	for _, filename := range filenames {
+		names = append(names, filepath.Base(filename))
+	}
+	expected := []string{"sample.go", "sampleExtra.go", "sampleGo118.go", "sampleNotGo118.go"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("files - received: %v - expected: %v", names, expected)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(dir, "sample.go"))
+	if err != nil || !strings.HasPrefix(string(source), generatedHeader) {
+		t.Errorf("sample.go - received: %q, %v - expected the generated header", source, err)
+	}
+}
@@ -2,6 +2,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"reflect"
@@ -13,19 +14,14 @@ import (
 
 // Import defines core language builtins - keys, range, println,  etc.
 func Import(e *env.Env) *env.Env {
-	e.Define("keys", func(v interface{}) []interface{} {
-		rv := reflect.ValueOf(v)
-		if rv.Kind() == reflect.Interface {
-			rv = rv.Elem()
-		}
-		mapKeysValue := rv.MapKeys()
-		mapKeys := make([]interface{}, len(mapKeysValue))
-		for i := 0; i < len(mapKeysValue); i++ {
-			mapKeys[i] = mapKeysValue[i].Interface()
-		}
-		return mapKeys
+	// keys has the VM function form so it gets the context of the run and sorts the keys in deterministic runs
+	e.Define("keys", func(ctx context.Context, v reflect.Value) (reflect.Value, reflect.Value) {
+		keys, err := mapKeys(ctx, v)
+		return reflect.ValueOf(keys), reflect.ValueOf(&err).Elem()
 	})
 
+	e.Define("orderedMap", vm.NewOrderedMap)
+
 	e.Define("range", func(args ...int64) []int64 {
 		var start, stop int64
 		var step int64 = 1
@@ -95,11 +91,45 @@ func Import(e *env.Env) *env.Env {
 		return rv
 	})
 
-	e.Define("print", fmt.Print)
-	e.Define("println", fmt.Println)
-	e.Define("printf", fmt.Printf)
+	// the context of the run is passed to Go functions with a first parameter of type context.Context
+	e.Define("context", func(ctx context.Context) context.Context {
+		return ctx
+	})
+
+	// the print builtins write to the stdout of the context of the run
+	e.Define("print", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprint(vm.Stdout(ctx), a...)
+	})
+	e.Define("println", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprintln(vm.Stdout(ctx), a...)
+	})
+	e.Define("printf", func(ctx context.Context, format string, a ...interface{}) (int, error) {
+		return fmt.Fprintf(vm.Stdout(ctx), format, a...)
+	})
 
 	ImportToX(e)
 
 	return e
 }
+
+// mapKeys returns the keys of a map, sorted with vm.SortValues in deterministic runs
+func mapKeys(ctx context.Context, v reflect.Value) ([]interface{}, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if m, ok := v.Interface().(*vm.OrderedMap); ok {
+		return m.Keys(), nil
+	}
+	if v.Kind() != reflect.Map {
+		return []interface{}{}, fmt.Errorf("keys argument must be a map, got %v", v.Type())
+	}
+	mapKeysValue := v.MapKeys()
+	if vm.IsDeterministic(ctx) {
+		vm.SortValues(mapKeysValue)
+	}
+	mapKeys := make([]interface{}, len(mapKeysValue))
+	for i := 0; i < len(mapKeysValue); i++ {
+		mapKeys[i] = mapKeysValue[i].Interface()
+	}
+	return mapKeys, nil
+}
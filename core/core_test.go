@@ -0,0 +1,41 @@
+package core
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		script string
+		value  interface{}
+		stdout string
+	}{
+		{script: `print("a", 1)`, value: []interface{}{2, nil}, stdout: "a1"},
+		{script: `println("a")`, value: []interface{}{2, nil}, stdout: "a\n"},
+		{script: `x = println("a", 1); x`, value: []interface{}{4, nil}, stdout: "a 1\n"},
+		{script: `printf("%v-%v\n", 1, "a")`, value: []interface{}{4, nil}, stdout: "1-a\n"},
+		{script: `a = [1, 2]; printf("%v %v\n", a...)`, value: []interface{}{4, nil}, stdout: "1 2\n"},
+		{script: `a = ["a", 1]; println(a...)`, value: []interface{}{4, nil}, stdout: "a 1\n"},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		Import(e)
+		var stdout bytes.Buffer
+		value, err := vm.Execute(e, &vm.Options{Stdout: &stdout}, test.script)
+		if err != nil {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, nil, test.script)
+			continue
+		}
+		if !reflect.DeepEqual(value, test.value) {
+			t.Errorf("value - received: %#v - expected: %#v - script: %v", value, test.value, test.script)
+		}
+		if stdout.String() != test.stdout {
+			t.Errorf("stdout - received: %q - expected: %q - script: %v", stdout.String(), test.stdout, test.script)
+		}
+	}
+}
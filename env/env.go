@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"reflect"
+	"sort"
 	"sync"
+	"time"
 )
 
 type (
@@ -22,11 +26,19 @@ type (
 		values         map[string]reflect.Value
 		types          map[string]reflect.Type
 		externalLookup ExternalLookup
+		registry       *Registry
+		readOnly       bool
+		raiseErrors    bool
+		consts         map[string]struct{}
 	}
+
+	// mapLookup is an ExternalLookup of the values in a map
+	mapLookup map[string]interface{}
 )
 
 var (
 	// Packages is a where packages can be stored so VM import command can be used to import them.
+	// These global maps are used by every Env that has no Registry, see SetRegistry.
 	// reflect.Value must be valid or VM may crash.
 	// For nil must use NilValue.
 	Packages = make(map[string]map[string]reflect.Value)
@@ -34,6 +46,27 @@ var (
 	// reflect.Type must be valid or VM may crash.
 	// For nil type must use NilType.
 	PackageTypes = make(map[string]map[string]reflect.Type)
+	// PackageExtraValues is where values written for anko can be added to the packages of Packages,
+	// so the generated maps of Packages can be replaced without losing them.
+	// The VM import command defines them over the values in Packages.
+	PackageExtraValues = make(map[string]map[string]reflect.Value)
+	// PackageExtraTypes is where types written for anko can be added to the packages of PackageTypes.
+	// The VM import command defines them over the types in PackageTypes.
+	PackageExtraTypes = make(map[string]map[string]reflect.Type)
+	// PackageStreamValues is where packages that use stdout, stderr or stdin can store a function
+	// that returns package values using the streams of a VM run in place of os.Stdout, os.Stderr and os.Stdin.
+	// The VM import command defines the returned values over the values in Packages.
+	PackageStreamValues = make(map[string]func(stdout io.Writer, stderr io.Writer, stdin io.Reader) map[string]reflect.Value)
+	// PackageDeterministicValues is where packages that use the clock or random numbers can store a function
+	// that returns package values using the clock now and the random numbers of a deterministic VM run.
+	// The VM import command defines the returned values over the values in Packages.
+	PackageDeterministicValues = make(map[string]func(now func() time.Time, random *rand.Rand) map[string]reflect.Value)
+	// InterfaceAdapters are the adapter types of Go interface types, so the VM can convert
+	// a script map or module of functions to the interface type.
+	// An adapter type is a struct with a func field for each method of the interface,
+	// named by the struct tag anko:"name" or else by the field name, and a pointer to it must implement the interface
+	// by calling its func fields. anko-package-gen -adapters generates them.
+	InterfaceAdapters = make(map[reflect.Type]reflect.Type)
 
 	// NilType is the reflect.type of nil
 	NilType = reflect.TypeOf(nil)
@@ -43,6 +76,7 @@ var (
 	basicTypes = map[string]reflect.Type{
 		"interface": reflect.ValueOf([]interface{}{int64(1)}).Index(0).Type(),
 		"bool":      reflect.TypeOf(true),
+		"error":     reflect.TypeOf((*error)(nil)).Elem(),
 		"string":    reflect.TypeOf("a"),
 		"int":       reflect.TypeOf(int(1)),
 		"int32":     reflect.TypeOf(int32(1)),
@@ -58,6 +92,8 @@ var (
 
 	// ErrSymbolContainsDot symbol contains .
 	ErrSymbolContainsDot = errors.New("symbol contains '.'")
+	// ErrReadOnly env is read-only, like the env of an imported package
+	ErrReadOnly = errors.New("env is read-only")
 )
 
 // NewEnv creates new global scope.
@@ -68,6 +104,15 @@ func NewEnv() *Env {
 	}
 }
 
+// NewEnvFromMap creates new global scope that looks up values in the map.
+// The map is not copied, it is read every time a value is looked up
+// so it must not be modified while a VM is running in the Env.
+func NewEnvFromMap(values map[string]interface{}) *Env {
+	e := NewEnv()
+	e.externalLookup = mapLookup(values)
+	return e
+}
+
 // NewEnv creates new child scope.
 func (e *Env) NewEnv() *Env {
 	return &Env{
@@ -93,6 +138,23 @@ func (e *Env) SetExternalLookup(externalLookup ExternalLookup) {
 	e.externalLookup = externalLookup
 }
 
+// Get returns the value of symbol in the map.
+func (m mapLookup) Get(symbol string) (reflect.Value, error) {
+	value, ok := m[symbol]
+	if !ok {
+		return NilValue, fmt.Errorf("undefined symbol '%s'", symbol)
+	}
+	if value == nil {
+		return NilValue, nil
+	}
+	return reflect.ValueOf(value), nil
+}
+
+// Type returns an error because maps do not define types.
+func (m mapLookup) Type(symbol string) (reflect.Type, error) {
+	return NilType, fmt.Errorf("undefined type '%s'", symbol)
+}
+
 // String returns string of values and types in current scope.
 func (e *Env) String() string {
 	var buffer bytes.Buffer
@@ -116,6 +178,19 @@ func (e *Env) String() string {
 	return buffer.String()
 }
 
+// Symbols returns the sorted symbols of the values in current scope.
+// Values of an external lookup are not included.
+func (e *Env) Symbols() []string {
+	e.rwMutex.RLock()
+	symbols := make([]string, 0, len(e.values))
+	for symbol := range e.values {
+		symbols = append(symbols, symbol)
+	}
+	e.rwMutex.RUnlock()
+	sort.Strings(symbols)
+	return symbols
+}
+
 // GetEnvFromPath returns Env from path
 func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 	if len(path) < 1 {
@@ -154,14 +229,20 @@ func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 	return e, nil
 }
 
-// Copy the Env for current scope
+// Copy the Env for current scope.
+// A read-only Env can not change so it is returned as is.
 func (e *Env) Copy() *Env {
+	if e.readOnly {
+		return e
+	}
 	e.rwMutex.RLock()
 	copy := Env{
 		rwMutex:        &sync.RWMutex{},
 		parent:         e.parent,
 		values:         make(map[string]reflect.Value, len(e.values)),
 		externalLookup: e.externalLookup,
+		registry:       e.registry,
+		raiseErrors:    e.raiseErrors,
 	}
 	for name, value := range e.values {
 		copy.values[name] = value
@@ -172,13 +253,23 @@ func (e *Env) Copy() *Env {
 			copy.types[name] = t
 		}
 	}
+	if e.consts != nil {
+		copy.consts = make(map[string]struct{}, len(e.consts))
+		for name := range e.consts {
+			copy.consts[name] = struct{}{}
+		}
+	}
 	e.rwMutex.RUnlock()
 	return &copy
 }
 
 // DeepCopy the Env for current scope and parent scopes.
 // Note that each scope is a consistent snapshot but not the whole.
+// A read-only Env and its parent scopes are returned as is.
 func (e *Env) DeepCopy() *Env {
+	if e.readOnly {
+		return e
+	}
 	e = e.Copy()
 	if e.parent != nil {
 		e.parent = e.parent.DeepCopy()
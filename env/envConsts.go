@@ -0,0 +1,75 @@
+package env
+
+import (
+	"reflect"
+)
+
+// ConstError is the error of assigning to, redefining or deleting a constant symbol
+type ConstError struct {
+	Symbol string
+	Op     string // assign to, redefine or delete
+}
+
+// Error returns the message of the error
+func (err *ConstError) Error() string {
+	return "cannot " + err.Op + " constant '" + err.Symbol + "'"
+}
+
+// DefineConst defines interface value to symbol in current scope as a constant.
+// A constant can not be set, redefined or deleted, but child scopes can define the symbol again.
+func (e *Env) DefineConst(symbol string, value interface{}) error {
+	if value == nil {
+		return e.DefineConstValue(symbol, NilValue)
+	}
+	return e.DefineConstValue(symbol, reflect.ValueOf(value))
+}
+
+// DefineConstValue defines reflect value to symbol in current scope as a constant, see DefineConst.
+func (e *Env) DefineConstValue(symbol string, value reflect.Value) error {
+	err := e.DefineValue(symbol, value)
+	if err != nil {
+		return err
+	}
+	e.rwMutex.Lock()
+	if e.consts == nil {
+		e.consts = make(map[string]struct{})
+	}
+	e.consts[symbol] = struct{}{}
+	e.rwMutex.Unlock()
+	return nil
+}
+
+// Freeze makes the symbols defined in current scope constants, see DefineConst.
+// Symbols defined later are not constants.
+func (e *Env) Freeze() {
+	e.rwMutex.Lock()
+	if e.consts == nil {
+		e.consts = make(map[string]struct{}, len(e.values))
+	}
+	for symbol := range e.values {
+		e.consts[symbol] = struct{}{}
+	}
+	e.rwMutex.Unlock()
+}
+
+// IsConst returns true if symbol is a constant in the scope where symbol is first found.
+func (e *Env) IsConst(symbol string) bool {
+	for ; e != nil; e = e.parent {
+		e.rwMutex.RLock()
+		_, ok := e.values[symbol]
+		_, isConst := e.consts[symbol]
+		e.rwMutex.RUnlock()
+		if ok {
+			return isConst
+		}
+	}
+	return false
+}
+
+// isConst returns true if symbol is a constant of current scope
+func (e *Env) isConst(symbol string) bool {
+	e.rwMutex.RLock()
+	_, ok := e.consts[symbol]
+	e.rwMutex.RUnlock()
+	return ok
+}
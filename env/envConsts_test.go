@@ -0,0 +1,125 @@
+package env
+
+import (
+	"testing"
+)
+
+func TestDefineConst(t *testing.T) {
+	env := NewEnv()
+	err := env.DefineConst("a", "a")
+	if err != nil {
+		t.Fatalf("DefineConst error - received: %v - expected: %v", err, nil)
+	}
+
+	value, err := env.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+	if !env.IsConst("a") || env.IsConst("b") {
+		t.Errorf("IsConst - received: %v, %v - expected: %v, %v", env.IsConst("a"), env.IsConst("b"), true, false)
+	}
+
+	tests := []struct {
+		info          string
+		err           error
+		expectedError string
+	}{
+		{info: "Set", err: env.Set("a", "b"), expectedError: "cannot assign to constant 'a'"},
+		{info: "Define", err: env.Define("a", "b"), expectedError: "cannot redefine constant 'a'"},
+		{info: "DefineConst", err: env.DefineConst("a", "b"), expectedError: "cannot redefine constant 'a'"},
+		{info: "DeleteErr", err: env.DeleteErr("a"), expectedError: "cannot delete constant 'a'"},
+		{info: "DeleteGlobalErr", err: env.NewEnv().DeleteGlobalErr("a"), expectedError: "cannot delete constant 'a'"},
+		{info: "child Set", err: env.NewEnv().Set("a", "b"), expectedError: "cannot assign to constant 'a'"},
+	}
+	for _, test := range tests {
+		if test.err == nil || test.err.Error() != test.expectedError {
+			t.Errorf("%v error - received: %v - expected: %v", test.info, test.err, test.expectedError)
+		}
+		if _, ok := test.err.(*ConstError); !ok {
+			t.Errorf("%v error type - received: %T - expected: %T", test.info, test.err, &ConstError{})
+		}
+	}
+
+	value, err = env.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+
+	// child scopes can define the symbol again
+	envChild := env.NewEnv()
+	err = envChild.Define("a", "b")
+	if err != nil {
+		t.Errorf("child Define error - received: %v - expected: %v", err, nil)
+	}
+	err = envChild.Set("a", "c")
+	if err != nil {
+		t.Errorf("child Set error - received: %v - expected: %v", err, nil)
+	}
+	if envChild.IsConst("a") {
+		t.Errorf("child IsConst - received: %v - expected: %v", true, false)
+	}
+
+	// constants are kept by Copy
+	err = env.Copy().Set("a", "b")
+	if err == nil || err.Error() != "cannot assign to constant 'a'" {
+		t.Errorf("Copy Set error - received: %v - expected: %v", err, "cannot assign to constant 'a'")
+	}
+
+	// constants of the scope and the parent scopes are kept by DeepCopy
+	envChild = env.NewEnv()
+	err = envChild.DefineConst("c", "c")
+	if err != nil {
+		t.Fatalf("child DefineConst error - received: %v - expected: %v", err, nil)
+	}
+	envCopy := envChild.DeepCopy()
+	if !envCopy.IsConst("a") || !envCopy.IsConst("c") {
+		t.Errorf("DeepCopy IsConst - received: %v, %v - expected: %v, %v", envCopy.IsConst("a"), envCopy.IsConst("c"), true, true)
+	}
+	err = envCopy.DeleteGlobalErr("a")
+	if err == nil || err.Error() != "cannot delete constant 'a'" {
+		t.Errorf("DeepCopy DeleteGlobalErr error - received: %v - expected: %v", err, "cannot delete constant 'a'")
+	}
+
+	// Delete and DeleteGlobal keep constants without returning an error
+	env.Delete("a")
+	env.NewEnv().DeleteGlobal("a")
+	value, err = env.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+
+	err = env.DefineConst("b", nil)
+	if err != nil {
+		t.Errorf("DefineConst nil error - received: %v - expected: %v", err, nil)
+	}
+	value, err = env.Get("b")
+	if err != nil || value != nil {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, nil, nil)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	env := NewEnv()
+	env.Define("a", "a")
+	env.Freeze()
+	env.Define("b", "b")
+
+	err := env.Set("a", "b")
+	if err == nil || err.Error() != "cannot assign to constant 'a'" {
+		t.Errorf("Set error - received: %v - expected: %v", err, "cannot assign to constant 'a'")
+	}
+	err = env.Set("b", "c")
+	if err != nil {
+		t.Errorf("Set error - received: %v - expected: %v", err, nil)
+	}
+	if !env.IsConst("a") || env.IsConst("b") {
+		t.Errorf("IsConst - received: %v, %v - expected: %v, %v", env.IsConst("a"), env.IsConst("b"), true, false)
+	}
+
+	env = NewEnv()
+	env.Freeze()
+	err = env.Define("a", "a")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+}
@@ -0,0 +1,207 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// ModuleOptions are the options of DefineModuleFromValueWithOptions.
+	ModuleOptions struct {
+		// Interface if not nil is an interface type the value implements,
+		// only the methods of the interface are defined and the fields are not
+		Interface reflect.Type
+	}
+
+	// fieldLookup is the ExternalLookup of the fields of a struct of a module made by DefineModuleFromValue
+	fieldLookup struct {
+		value  reflect.Value
+		fields map[string][]int
+	}
+)
+
+// DefineModuleFromValue creates a module of a Go value and defines it as a symbol, like a module statement does.
+// The exported methods of the value are the functions of the module.
+// When the value is a struct or a pointer to a struct the exported fields can be read and set through the module,
+// a struct that is not a pointer is copied. The struct tag anko:"name" sets the name of a field
+// and anko:"-" hides it. Methods are found before fields of the same name.
+// Fields of embedded structs and pointers to structs are promoted, numbers are only set to fields that can hold them.
+func (e *Env) DefineModuleFromValue(symbol string, value interface{}) (*Env, error) {
+	return e.DefineModuleFromValueWithOptions(symbol, value, nil)
+}
+
+// DefineModuleFromValueWithOptions creates a module of a Go value with options and defines it as a symbol.
+// See DefineModuleFromValue.
+func (e *Env) DefineModuleFromValueWithOptions(symbol string, value interface{}, options *ModuleOptions) (*Env, error) {
+	if options == nil {
+		options = &ModuleOptions{}
+	}
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return nil, fmt.Errorf("cannot make module of nil")
+	}
+	if rv.Kind() == reflect.Struct {
+		// copy to be able to set fields and call pointer methods
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+
+	module := e.NewEnv()
+
+	if options.Interface != nil {
+		if options.Interface.Kind() != reflect.Interface {
+			return nil, fmt.Errorf("module interface type %v is not an interface", options.Interface)
+		}
+		if !rv.Type().Implements(options.Interface) {
+			return nil, fmt.Errorf("type %v does not implement %v", rv.Type(), options.Interface)
+		}
+		for i := 0; i < options.Interface.NumMethod(); i++ {
+			name := options.Interface.Method(i).Name
+			err := module.DefineValue(name, rv.MethodByName(name))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return module, e.DefineValue(symbol, reflect.ValueOf(module))
+	}
+
+	for i := 0; i < rv.NumMethod(); i++ {
+		err := module.DefineValue(rv.Type().Method(i).Name, rv.Method(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Struct {
+		lookup := &fieldLookup{value: rv.Elem(), fields: make(map[string][]int)}
+		lookup.addFields(rv.Elem().Type(), nil, map[reflect.Type]bool{rv.Elem().Type(): true})
+		module.externalLookup = lookup
+	}
+
+	return module, e.DefineValue(symbol, reflect.ValueOf(module))
+}
+
+// addFields adds the exported fields of structType, the fields of embedded structs and pointers to structs
+// without a name tag are added as well unless a field of the outer struct has the same name.
+// seen has the struct types already added so embedded pointers to the same type do not recurse forever.
+func (lookup *fieldLookup) addFields(structType reflect.Type, index []int, seen map[reflect.Type]bool) {
+	var embedded []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("anko")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && tag == "" && embeddedStructType(field.Type) != nil {
+			field.Index = append(append([]int{}, index...), i)
+			embedded = append(embedded, field)
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		name := field.Name
+		if tag != "" {
+			name = tag
+		}
+		if strings.Contains(name, ".") {
+			continue
+		}
+		if _, ok := lookup.fields[name]; ok {
+			continue
+		}
+		lookup.fields[name] = append(append([]int{}, index...), i)
+	}
+
+	for _, field := range embedded {
+		structType := embeddedStructType(field.Type)
+		if seen[structType] {
+			continue
+		}
+		seen[structType] = true
+		lookup.addFields(structType, field.Index, seen)
+	}
+}
+
+// embeddedStructType returns the struct type of a struct or a pointer to a struct, otherwise nil
+func embeddedStructType(fieldType reflect.Type) reflect.Type {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() != reflect.Struct {
+		return nil
+	}
+	return fieldType
+}
+
+// field returns the field of symbol, returns an error if the field is in a nil embedded pointer
+func (lookup *fieldLookup) field(symbol string, index []int) (reflect.Value, error) {
+	value := lookup.value
+	for i, x := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return reflect.Value{}, fmt.Errorf("cannot access field %v through nil embedded pointer %v", symbol, value.Type())
+			}
+			value = value.Elem()
+		}
+		value = value.Field(x)
+	}
+	return value, nil
+}
+
+// Get returns a copy of the value of a field.
+func (lookup *fieldLookup) Get(symbol string) (reflect.Value, error) {
+	index, ok := lookup.fields[symbol]
+	if !ok {
+		return NilValue, fmt.Errorf("undefined symbol '%s'", symbol)
+	}
+	field, err := lookup.field(symbol, index)
+	if err != nil {
+		return NilValue, err
+	}
+	value := field.Interface()
+	if value == nil {
+		return NilValue, nil
+	}
+	return reflect.ValueOf(value), nil
+}
+
+// Type returns an error because structs do not define types.
+func (lookup *fieldLookup) Type(symbol string) (reflect.Type, error) {
+	return NilType, fmt.Errorf("undefined type '%s'", symbol)
+}
+
+// setValue sets the value of a field, returns false if there is no field named symbol
+func (lookup *fieldLookup) setValue(symbol string, value reflect.Value) (bool, error) {
+	index, ok := lookup.fields[symbol]
+	if !ok {
+		return false, nil
+	}
+	field, err := lookup.field(symbol, index)
+	if err != nil {
+		return true, err
+	}
+
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	switch {
+	case !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()):
+		field.Set(reflect.Zero(field.Type()))
+	case value.Type().AssignableTo(field.Type()):
+		field.Set(value)
+	case value.Type().ConvertibleTo(field.Type()) && (field.Kind() != reflect.String || value.Kind() == reflect.String):
+		// numbers are not converted to strings of runes
+		converted := value.Convert(field.Type())
+		if IsLossyConversion(value, converted) {
+			return true, fmt.Errorf("cannot use %v of type %v as type %v to set field %v without loss", value, value.Type(), field.Type(), symbol)
+		}
+		field.Set(converted)
+	default:
+		return true, fmt.Errorf("cannot use type %v as type %v to set field %v", value.Type(), field.Type(), symbol)
+	}
+	return true, nil
+}
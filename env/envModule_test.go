@@ -0,0 +1,243 @@
+package env
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testModuleBase struct {
+	Base  string
+	Count int
+}
+
+type testModuleService struct {
+	testModuleBase
+	Name    string
+	Renamed int64  `anko:"renamed"`
+	Hidden  string `anko:"-"`
+	private int
+}
+
+func (s *testModuleService) Hello() string { return "hello " + s.Name }
+
+func (s *testModuleService) Add(n int64) int64 {
+	s.Renamed += n
+	return s.Renamed
+}
+
+type testModuleHello interface {
+	Hello() string
+}
+
+func TestDefineModuleFromValue(t *testing.T) {
+	service := &testModuleService{testModuleBase: testModuleBase{Base: "base"}, Name: "a", Renamed: 1, Hidden: "hidden"}
+	e := NewEnv()
+	module, err := e.DefineModuleFromValue("svc", service)
+	if err != nil {
+		t.Fatalf("DefineModuleFromValue error - received: %v - expected: %v", err, nil)
+	}
+
+	value, err := e.Get("svc")
+	if err != nil || value != module {
+		t.Fatalf("Get svc - received: %v, %v - expected: %v, %v", value, err, module, nil)
+	}
+
+	tests := []struct {
+		symbol string
+		value  interface{}
+		err    error
+	}{
+		{symbol: "Name", value: "a"},
+		{symbol: "renamed", value: int64(1)},
+		{symbol: "Base", value: "base"},
+		{symbol: "Count", value: 0},
+		{symbol: "Renamed", err: fmt.Errorf("undefined symbol 'Renamed'")},
+		{symbol: "Hidden", err: fmt.Errorf("undefined symbol 'Hidden'")},
+		{symbol: "private", err: fmt.Errorf("undefined symbol 'private'")},
+	}
+	for _, test := range tests {
+		value, err := module.Get(test.symbol)
+		if err != nil || test.err != nil {
+			if err == nil || test.err == nil || err.Error() != test.err.Error() {
+				t.Errorf("Get %v error - received: %v - expected: %v", test.symbol, err, test.err)
+			}
+			continue
+		}
+		if value != test.value {
+			t.Errorf("Get %v - received: %#v - expected: %#v", test.symbol, value, test.value)
+		}
+	}
+
+	hello, err := module.Get("Hello")
+	if err != nil {
+		t.Fatalf("Get Hello error - received: %v - expected: %v", err, nil)
+	}
+	if result := hello.(func() string)(); result != "hello a" {
+		t.Errorf("Hello - received: %v - expected: %v", result, "hello a")
+	}
+
+	err = module.Set("Name", "b")
+	if err != nil {
+		t.Fatalf("Set Name error - received: %v - expected: %v", err, nil)
+	}
+	err = module.Set("Count", int64(2))
+	if err != nil {
+		t.Fatalf("Set Count error - received: %v - expected: %v", err, nil)
+	}
+	if service.Name != "b" || service.Count != 2 {
+		t.Errorf("Set - received: %v, %v - expected: %v, %v", service.Name, service.Count, "b", 2)
+	}
+	err = module.Set("Name", nil)
+	if err != nil || service.Name != "" {
+		t.Errorf("Set Name nil - received: %q, %v - expected: %q, %v", service.Name, err, "", nil)
+	}
+	err = module.Set("Name", []int{1})
+	if err == nil || !strings.Contains(err.Error(), "cannot use type []int as type string") {
+		t.Errorf("Set Name error - received: %v - expected: %v", err, "cannot use type []int as type string")
+	}
+	err = module.Set("Hidden", "b")
+	if err == nil || err.Error() != "undefined symbol 'Hidden'" {
+		t.Errorf("Set Hidden error - received: %v - expected: %v", err, "undefined symbol 'Hidden'")
+	}
+}
+
+func TestDefineModuleFromValueStruct(t *testing.T) {
+	service := testModuleService{Name: "a"}
+	e := NewEnv()
+	module, err := e.DefineModuleFromValue("svc", service)
+	if err != nil {
+		t.Fatalf("DefineModuleFromValue error - received: %v - expected: %v", err, nil)
+	}
+
+	// struct values are copied so pointer methods can be called
+	add, err := module.Get("Add")
+	if err != nil {
+		t.Fatalf("Get Add error - received: %v - expected: %v", err, nil)
+	}
+	add.(func(int64) int64)(2)
+	value, err := module.Get("renamed")
+	if err != nil || value != int64(2) {
+		t.Errorf("Get renamed - received: %v, %v - expected: %v, %v", value, err, int64(2), nil)
+	}
+	if service.Renamed != 0 {
+		t.Errorf("Renamed - received: %v - expected: %v", service.Renamed, 0)
+	}
+
+	_, err = e.DefineModuleFromValue("svc", nil)
+	if err == nil || err.Error() != "cannot make module of nil" {
+		t.Errorf("DefineModuleFromValue nil error - received: %v - expected: %v", err, "cannot make module of nil")
+	}
+	_, err = e.DefineModuleFromValue("a.b", service)
+	if err != ErrSymbolContainsDot {
+		t.Errorf("DefineModuleFromValue a.b error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+}
+
+func TestDefineModuleFromValueInterface(t *testing.T) {
+	e := NewEnv()
+	options := &ModuleOptions{Interface: reflect.TypeOf((*testModuleHello)(nil)).Elem()}
+	module, err := e.DefineModuleFromValueWithOptions("svc", &testModuleService{Name: "a"}, options)
+	if err != nil {
+		t.Fatalf("DefineModuleFromValueWithOptions error - received: %v - expected: %v", err, nil)
+	}
+
+	if _, err = module.Get("Hello"); err != nil {
+		t.Errorf("Get Hello error - received: %v - expected: %v", err, nil)
+	}
+	for _, symbol := range []string{"Add", "Name"} {
+		_, err = module.Get(symbol)
+		if err == nil {
+			t.Errorf("Get %v error - received: %v - expected: %v", symbol, err, "undefined symbol")
+		}
+	}
+
+	_, err = e.DefineModuleFromValueWithOptions("svc", 1, options)
+	if err == nil || err.Error() != "type int does not implement env.testModuleHello" {
+		t.Errorf("DefineModuleFromValueWithOptions error - received: %v - expected: %v", err, "type int does not implement env.testModuleHello")
+	}
+	_, err = e.DefineModuleFromValueWithOptions("svc", 1, &ModuleOptions{Interface: reflect.TypeOf(1)})
+	if err == nil || err.Error() != "module interface type int is not an interface" {
+		t.Errorf("DefineModuleFromValueWithOptions error - received: %v - expected: %v", err, "module interface type int is not an interface")
+	}
+}
+
+type testModuleNumbers struct {
+	*testModuleBase
+	Int   int
+	Uint8 uint8
+	Float float32
+}
+
+type testModuleNode struct {
+	*testModuleNode
+	Name string
+}
+
+func TestDefineModuleFromValueSetNumbers(t *testing.T) {
+	numbers := &testModuleNumbers{}
+	e := NewEnv()
+	module, err := e.DefineModuleFromValue("numbers", numbers)
+	if err != nil {
+		t.Fatalf("DefineModuleFromValue error - received: %v - expected: %v", err, nil)
+	}
+
+	tests := []struct {
+		symbol string
+		value  interface{}
+		err    error
+	}{
+		{symbol: "Int", value: int64(2)},
+		{symbol: "Int", value: float64(3)},
+		{symbol: "Int", value: float64(1.5), err: fmt.Errorf("cannot use 1.5 of type float64 as type int to set field Int without loss")},
+		{symbol: "Uint8", value: int64(255)},
+		{symbol: "Uint8", value: int64(256), err: fmt.Errorf("cannot use 256 of type int64 as type uint8 to set field Uint8 without loss")},
+		{symbol: "Uint8", value: int64(-1), err: fmt.Errorf("cannot use -1 of type int64 as type uint8 to set field Uint8 without loss")},
+		{symbol: "Float", value: float64(0.5)},
+		{symbol: "Float", value: math.NaN()},
+		{symbol: "Float", value: float64(0.1), err: fmt.Errorf("cannot use 0.1 of type float64 as type float32 to set field Float without loss")},
+	}
+	for _, test := range tests {
+		err = module.Set(test.symbol, test.value)
+		if err != nil || test.err != nil {
+			if err == nil || test.err == nil || err.Error() != test.err.Error() {
+				t.Errorf("Set %v %v error - received: %v - expected: %v", test.symbol, test.value, err, test.err)
+			}
+		}
+	}
+	if numbers.Int != 3 || numbers.Uint8 != 255 || !math.IsNaN(float64(numbers.Float)) {
+		t.Errorf("Set - received: %v, %v, %v - expected: %v, %v, %v", numbers.Int, numbers.Uint8, numbers.Float, 3, 255, math.NaN())
+	}
+
+	// fields of a nil embedded pointer are defined but cannot be accessed
+	_, err = module.externalLookup.Get("Base")
+	if err == nil || err.Error() != "cannot access field Base through nil embedded pointer *env.testModuleBase" {
+		t.Errorf("Get Base error - received: %v - expected: %v", err, "cannot access field Base through nil embedded pointer *env.testModuleBase")
+	}
+	err = module.Set("Base", "a")
+	if err == nil || err.Error() != "cannot access field Base through nil embedded pointer *env.testModuleBase" {
+		t.Errorf("Set Base error - received: %v - expected: %v", err, "cannot access field Base through nil embedded pointer *env.testModuleBase")
+	}
+
+	numbers.testModuleBase = &testModuleBase{Base: "base"}
+	value, err := module.Get("Base")
+	if err != nil || value != "base" {
+		t.Errorf("Get Base - received: %v, %v - expected: %v, %v", value, err, "base", nil)
+	}
+	err = module.Set("Count", int64(2))
+	if err != nil || numbers.Count != 2 {
+		t.Errorf("Set Count - received: %v, %v - expected: %v, %v", numbers.Count, err, 2, nil)
+	}
+
+	// embedded pointers to the same type do not recurse forever
+	module, err = e.DefineModuleFromValue("node", &testModuleNode{Name: "a"})
+	if err != nil {
+		t.Fatalf("DefineModuleFromValue error - received: %v - expected: %v", err, nil)
+	}
+	value, err = module.Get("Name")
+	if err != nil || value != "a" {
+		t.Errorf("Get Name - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+}
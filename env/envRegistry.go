@@ -0,0 +1,323 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	globalPackagesMutex sync.Mutex
+	globalPackages      = make(map[string]*globalPackage)
+)
+
+type (
+	// Package is a package the VM import command can import.
+	Package struct {
+		// Values are the values of the package, reflect.Value must be valid or VM may crash
+		Values map[string]reflect.Value
+		// Types are the types of the package, reflect.Type must be valid or VM may crash
+		Types map[string]reflect.Type
+		// StreamValues if not nil returns values that use the streams of a VM run,
+		// the VM import command defines them over Values when the run sets streams
+		StreamValues func(stdout io.Writer, stderr io.Writer, stdin io.Reader) map[string]reflect.Value
+		// DeterministicValues if not nil returns values that use the clock and random numbers of a deterministic VM run,
+		// the VM import command defines them over Values in deterministic runs
+		DeterministicValues func(now func() time.Time, random *rand.Rand) map[string]reflect.Value
+		// RaiseErrors makes the VM throw the non-nil last error return values of calls to the package functions,
+		// like a try call does, see Env RaisesErrors
+		RaiseErrors bool
+
+		once sync.Once
+		env  *Env
+		err  error
+	}
+
+	// globalPackage is a Package made of the global package maps, with the maps it was made of
+	globalPackage struct {
+		values      map[string]reflect.Value
+		extraValues map[string]reflect.Value
+		types       map[string]reflect.Type
+		extraTypes  map[string]reflect.Type
+		pack        *Package
+	}
+
+	// Registry is a set of packages the VM import command can import.
+	// A Registry is attached to an Env with SetRegistry, so each interpreter can have its own packages.
+	// Packages that are not registered are looked up in the global Packages, PackageTypes, PackageExtraValues, PackageExtraTypes,
+	// PackageStreamValues and PackageDeterministicValues maps, unless they are removed or the fallback is turned off with SetFallback.
+	Registry struct {
+		mutex    sync.Mutex
+		packages map[string]*Package
+		funcs    map[string]func() *Package
+		removed  map[string]struct{}
+		fallback bool
+	}
+)
+
+// NewRegistry creates a package registry that falls back to the global package maps.
+func NewRegistry() *Registry {
+	return &Registry{
+		packages: make(map[string]*Package),
+		funcs:    make(map[string]func() *Package),
+		removed:  make(map[string]struct{}),
+		fallback: true,
+	}
+}
+
+// SetFallback sets if packages that are not registered are looked up in the global package maps.
+func (registry *Registry) SetFallback(fallback bool) {
+	registry.mutex.Lock()
+	registry.fallback = fallback
+	registry.mutex.Unlock()
+}
+
+// Register registers a package, replacing a package of the same name.
+func (registry *Registry) Register(name string, pack *Package) {
+	registry.mutex.Lock()
+	registry.packages[name] = pack
+	delete(registry.funcs, name)
+	delete(registry.removed, name)
+	registry.mutex.Unlock()
+}
+
+// RegisterFunc registers a package that is made by newPackage the first time it is looked up,
+// replacing a package of the same name. newPackage must not use the registry.
+func (registry *Registry) RegisterFunc(name string, newPackage func() *Package) {
+	registry.mutex.Lock()
+	registry.funcs[name] = newPackage
+	delete(registry.packages, name)
+	delete(registry.removed, name)
+	registry.mutex.Unlock()
+}
+
+// Remove removes a package, a package of the global package maps is no longer found either.
+func (registry *Registry) Remove(name string) {
+	registry.mutex.Lock()
+	delete(registry.packages, name)
+	delete(registry.funcs, name)
+	registry.removed[name] = struct{}{}
+	registry.mutex.Unlock()
+}
+
+// Lookup returns the package name.
+func (registry *Registry) Lookup(name string) (*Package, bool) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	pack, ok := registry.packages[name]
+	if ok {
+		return pack, true
+	}
+	newPackage, ok := registry.funcs[name]
+	if ok {
+		pack = newPackage()
+		delete(registry.funcs, name)
+		if pack == nil {
+			registry.removed[name] = struct{}{}
+			return nil, false
+		}
+		registry.packages[name] = pack
+		return pack, true
+	}
+
+	_, ok = registry.removed[name]
+	if ok || !registry.fallback {
+		return nil, false
+	}
+	return lookupGlobalPackage(name)
+}
+
+// Names returns the sorted names of the packages.
+func (registry *Registry) Names() []string {
+	registry.mutex.Lock()
+	names := make(map[string]struct{}, len(registry.packages)+len(registry.funcs))
+	for name := range registry.packages {
+		names[name] = struct{}{}
+	}
+	for name := range registry.funcs {
+		names[name] = struct{}{}
+	}
+	if registry.fallback {
+		for name := range Packages {
+			if _, ok := registry.removed[name]; !ok {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	registry.mutex.Unlock()
+	return sortedNames(names)
+}
+
+// lookupGlobalPackage returns the package name of the global package maps, with the extra values and types merged.
+// The Package is kept so its Env is built once, it is made again when the maps of the package are replaced.
+func lookupGlobalPackage(name string) (*Package, bool) {
+	values, ok := Packages[name]
+	if !ok {
+		return nil, false
+	}
+	types := PackageTypes[name]
+	extraValues := PackageExtraValues[name]
+	extraTypes := PackageExtraTypes[name]
+
+	globalPackagesMutex.Lock()
+	defer globalPackagesMutex.Unlock()
+	global, ok := globalPackages[name]
+	if ok && sameMap(global.values, values) && sameMap(global.types, types) &&
+		sameMap(global.extraValues, extraValues) && sameMap(global.extraTypes, extraTypes) {
+		return global.pack, true
+	}
+	pack := &Package{Values: values, Types: types, StreamValues: PackageStreamValues[name], DeterministicValues: PackageDeterministicValues[name]}
+	if len(extraValues) > 0 {
+		pack.Values = make(map[string]reflect.Value, len(values)+len(extraValues))
+		for symbol, value := range values {
+			pack.Values[symbol] = value
+		}
+		for symbol, value := range extraValues {
+			pack.Values[symbol] = value
+		}
+	}
+	if len(extraTypes) > 0 {
+		pack.Types = make(map[string]reflect.Type, len(types)+len(extraTypes))
+		for symbol, reflectType := range types {
+			pack.Types[symbol] = reflectType
+		}
+		for symbol, reflectType := range extraTypes {
+			pack.Types[symbol] = reflectType
+		}
+	}
+	globalPackages[name] = &globalPackage{values: values, extraValues: extraValues, types: types, extraTypes: extraTypes, pack: pack}
+	return pack, true
+}
+
+// sameMap returns true if a and b are the same map or both nil
+func sameMap(a interface{}, b interface{}) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
+
+// Env returns the read-only Env of the package values and types.
+// The Env is built the first time and then shared by every import of the package,
+// so the values and types of the package must not be changed after that.
+func (pack *Package) Env() (*Env, error) {
+	pack.once.Do(func() {
+		e := NewEnv()
+		for symbol, value := range pack.Values {
+			err := e.DefineValue(symbol, value)
+			if err != nil {
+				pack.err = fmt.Errorf("DefineValue error: %v", err)
+				return
+			}
+		}
+		for symbol, reflectType := range pack.Types {
+			err := e.DefineReflectType(symbol, reflectType)
+			if err != nil {
+				pack.err = fmt.Errorf("DefineReflectType error: %v", err)
+				return
+			}
+		}
+		e.readOnly = true
+		e.raiseErrors = pack.RaiseErrors
+		pack.env = e
+	})
+	return pack.env, pack.err
+}
+
+// EnvWithStreams returns the read-only Env of the package with the StreamValues of the streams.
+// It is a new child of the shared Env of the package, or the shared Env when the package has no StreamValues.
+func (pack *Package) EnvWithStreams(stdout io.Writer, stderr io.Writer, stdin io.Reader) (*Env, error) {
+	packEnv, err := pack.Env()
+	if err != nil || pack.StreamValues == nil {
+		return packEnv, err
+	}
+	return pack.childEnv(packEnv, pack.StreamValues(stdout, stderr, stdin))
+}
+
+// EnvWithDeterministicValues returns the read-only Env of the package with the DeterministicValues of the clock and random numbers.
+// It is a new child of packEnv, the Env of the package or of EnvWithStreams, or packEnv when the package has no DeterministicValues.
+func (pack *Package) EnvWithDeterministicValues(packEnv *Env, now func() time.Time, random *rand.Rand) (*Env, error) {
+	if pack.DeterministicValues == nil {
+		return packEnv, nil
+	}
+	return pack.childEnv(packEnv, pack.DeterministicValues(now, random))
+}
+
+// childEnv returns a new read-only child of packEnv with the values that replace values of the package
+func (pack *Package) childEnv(packEnv *Env, values map[string]reflect.Value) (*Env, error) {
+	e := packEnv.NewEnv()
+	for symbol, value := range values {
+		if _, ok := pack.Values[symbol]; !ok {
+			continue
+		}
+		err := e.DefineValue(symbol, value)
+		if err != nil {
+			return nil, fmt.Errorf("DefineValue error: %v", err)
+		}
+	}
+	e.readOnly = true
+	e.raiseErrors = pack.RaiseErrors
+	return e, nil
+}
+
+// RaisesErrors returns true if the Env is the Env of a package with RaiseErrors.
+// The VM throws the non-nil last error return values of calls to its functions.
+func (e *Env) RaisesErrors() bool {
+	return e.raiseErrors
+}
+
+// sortedNames returns the sorted keys of names
+func sortedNames(names map[string]struct{}) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// SetRegistry sets the package registry of the Env, it is used by the child scopes too.
+func (e *Env) SetRegistry(registry *Registry) {
+	e.rwMutex.Lock()
+	e.registry = registry
+	e.rwMutex.Unlock()
+}
+
+// Registry returns the package registry of the Env or of its closest parent scope that has one.
+// Returns nil when no scope has a registry.
+func (e *Env) Registry() *Registry {
+	for ; e != nil; e = e.parent {
+		e.rwMutex.RLock()
+		registry := e.registry
+		e.rwMutex.RUnlock()
+		if registry != nil {
+			return registry
+		}
+	}
+	return nil
+}
+
+// LookupPackage returns the package name from the registry of the Env,
+// or from the global package maps when the Env has no registry.
+func (e *Env) LookupPackage(name string) (*Package, bool) {
+	registry := e.Registry()
+	if registry == nil {
+		return lookupGlobalPackage(name)
+	}
+	return registry.Lookup(name)
+}
+
+// PackageNames returns the sorted names of the packages that can be imported in the Env.
+func (e *Env) PackageNames() []string {
+	registry := e.Registry()
+	if registry == nil {
+		names := make(map[string]struct{}, len(Packages))
+		for name := range Packages {
+			names[name] = struct{}{}
+		}
+		return sortedNames(names)
+	}
+	return registry.Names()
+}
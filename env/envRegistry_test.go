@@ -0,0 +1,251 @@
+package env
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	Packages["testGlobal"] = map[string]reflect.Value{"a": reflect.ValueOf(1)}
+	defer delete(Packages, "testGlobal")
+
+	registry := NewRegistry()
+	registry.Register("testRegistered", &Package{Values: map[string]reflect.Value{"b": reflect.ValueOf(2)}})
+	calls := 0
+	registry.RegisterFunc("testFunc", func() *Package {
+		calls++
+		return &Package{Values: map[string]reflect.Value{"c": reflect.ValueOf(3)}}
+	})
+
+	tests := []struct {
+		name   string
+		symbol string
+		value  interface{}
+		found  bool
+	}{
+		{name: "testGlobal", symbol: "a", value: 1, found: true},
+		{name: "testRegistered", symbol: "b", value: 2, found: true},
+		{name: "testFunc", symbol: "c", value: 3, found: true},
+		{name: "testFunc", symbol: "c", value: 3, found: true},
+		{name: "testNotFound", found: false},
+	}
+	for _, test := range tests {
+		pack, ok := registry.Lookup(test.name)
+		if ok != test.found {
+			t.Errorf("Lookup %v - received: %v - expected: %v", test.name, ok, test.found)
+			continue
+		}
+		if ok && pack.Values[test.symbol].Interface() != test.value {
+			t.Errorf("Lookup %v %v - received: %v - expected: %v", test.name, test.symbol, pack.Values[test.symbol], test.value)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("RegisterFunc calls - received: %v - expected: %v", calls, 1)
+	}
+
+	names := registry.Names()
+	for _, name := range []string{"testFunc", "testGlobal", "testRegistered"} {
+		if !containsString(names, name) {
+			t.Errorf("Names - received: %v - expected to contain: %v", names, name)
+		}
+	}
+
+	registry.Remove("testGlobal")
+	registry.Remove("testRegistered")
+	for _, name := range []string{"testGlobal", "testRegistered"} {
+		if _, ok := registry.Lookup(name); ok {
+			t.Errorf("Lookup %v after Remove - received: %v - expected: %v", name, ok, false)
+		}
+		if containsString(registry.Names(), name) {
+			t.Errorf("Names after Remove - received: %v - expected not to contain: %v", registry.Names(), name)
+		}
+	}
+
+	registry = NewRegistry()
+	registry.SetFallback(false)
+	if _, ok := registry.Lookup("testGlobal"); ok {
+		t.Errorf("Lookup without fallback - received: %v - expected: %v", ok, false)
+	}
+}
+
+func TestEnvRegistry(t *testing.T) {
+	Packages["testGlobal"] = map[string]reflect.Value{"a": reflect.ValueOf(1)}
+	defer delete(Packages, "testGlobal")
+
+	env := NewEnv()
+	child := env.NewEnv()
+	if child.Registry() != nil {
+		t.Errorf("Registry - received: %v - expected: %v", child.Registry(), nil)
+	}
+	if _, ok := child.LookupPackage("testGlobal"); !ok {
+		t.Errorf("LookupPackage without registry - received: %v - expected: %v", ok, true)
+	}
+	if !containsString(child.PackageNames(), "testGlobal") {
+		t.Errorf("PackageNames without registry - received: %v - expected to contain: %v", child.PackageNames(), "testGlobal")
+	}
+
+	registry := NewRegistry()
+	registry.SetFallback(false)
+	registry.Register("testRegistered", &Package{})
+	env.SetRegistry(registry)
+	if child.Registry() != registry || child.Copy().Registry() != registry {
+		t.Errorf("Registry - received: %p - expected: %p", child.Registry(), registry)
+	}
+	if _, ok := child.LookupPackage("testGlobal"); ok {
+		t.Errorf("LookupPackage with registry - received: %v - expected: %v", ok, false)
+	}
+	if _, ok := child.LookupPackage("testRegistered"); !ok {
+		t.Errorf("LookupPackage with registry - received: %v - expected: %v", ok, true)
+	}
+	names := child.PackageNames()
+	if !reflect.DeepEqual(names, []string{"testRegistered"}) {
+		t.Errorf("PackageNames with registry - received: %v - expected: %v", names, []string{"testRegistered"})
+	}
+}
+
+func TestPackageExtras(t *testing.T) {
+	Packages["testExtras"] = map[string]reflect.Value{"a": reflect.ValueOf(1), "b": reflect.ValueOf(2)}
+	PackageTypes["testExtras"] = map[string]reflect.Type{"c": reflect.TypeOf(1)}
+	PackageExtraValues["testExtras"] = map[string]reflect.Value{"b": reflect.ValueOf("b")}
+	PackageExtraTypes["testExtras"] = map[string]reflect.Type{"d": reflect.TypeOf("d")}
+	defer func() {
+		delete(Packages, "testExtras")
+		delete(PackageTypes, "testExtras")
+		delete(PackageExtraValues, "testExtras")
+		delete(PackageExtraTypes, "testExtras")
+	}()
+
+	pack, ok := lookupGlobalPackage("testExtras")
+	if !ok {
+		t.Fatalf("lookupGlobalPackage - received: %v - expected: %v", ok, true)
+	}
+	if pack.Values["a"].Interface() != 1 || pack.Values["b"].Interface() != "b" {
+		t.Errorf("Values - received: %v - expected: %v", pack.Values, `a: 1, b: "b"`)
+	}
+	if pack.Types["c"] != reflect.TypeOf(1) || pack.Types["d"] != reflect.TypeOf("d") {
+		t.Errorf("Types - received: %v - expected: %v", pack.Types, "c: int, d: string")
+	}
+	if Packages["testExtras"]["b"].Interface() != 2 {
+		t.Errorf("Packages b - received: %v - expected: %v", Packages["testExtras"]["b"], 2)
+	}
+	if cached, _ := lookupGlobalPackage("testExtras"); cached != pack {
+		t.Errorf("lookupGlobalPackage - received: %p - expected: %p", cached, pack)
+	}
+
+	// the package is made again when the generated maps are replaced
+	Packages["testExtras"] = map[string]reflect.Value{"a": reflect.ValueOf(3)}
+	pack, _ = lookupGlobalPackage("testExtras")
+	if pack.Values["a"].Interface() != 3 || pack.Values["b"].Interface() != "b" {
+		t.Errorf("Values - received: %v - expected: %v", pack.Values, `a: 3, b: "b"`)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPackageEnv(t *testing.T) {
+	t.Parallel()
+
+	pack := &Package{
+		Values: map[string]reflect.Value{"a": reflect.ValueOf(1), "b": reflect.ValueOf(2)},
+		Types:  map[string]reflect.Type{"c": reflect.TypeOf(1)},
+		StreamValues: func(stdout io.Writer, stderr io.Writer, stdin io.Reader) map[string]reflect.Value {
+			return map[string]reflect.Value{"b": reflect.ValueOf(stdout), "d": reflect.ValueOf(stderr)}
+		},
+	}
+	packEnv, err := pack.Env()
+	if err != nil {
+		t.Fatal("Env error:", err)
+	}
+	cachedEnv, _ := pack.Env()
+	if cachedEnv != packEnv || packEnv.Copy() != packEnv || packEnv.DeepCopy() != packEnv {
+		t.Errorf("Env - received: %p %p %p - expected: %p", cachedEnv, packEnv.Copy(), packEnv.DeepCopy(), packEnv)
+	}
+
+	if err = packEnv.Define("e", 5); err != ErrReadOnly {
+		t.Errorf("Define - received: %v - expected: %v", err, ErrReadOnly)
+	}
+	if err = packEnv.Set("a", 5); err != ErrReadOnly {
+		t.Errorf("Set - received: %v - expected: %v", err, ErrReadOnly)
+	}
+	if err = packEnv.DefineType("f", 5); err != ErrReadOnly {
+		t.Errorf("DefineType - received: %v - expected: %v", err, ErrReadOnly)
+	}
+	packEnv.Delete("a")
+	if value, err := packEnv.Get("a"); err != nil || value != 1 {
+		t.Errorf("Get after Delete - received: %v %v - expected: %v", value, err, 1)
+	}
+
+	var stdout strings.Builder
+	streamsEnv, err := pack.EnvWithStreams(&stdout, nil, nil)
+	if err != nil {
+		t.Fatal("EnvWithStreams error:", err)
+	}
+	if value, err := streamsEnv.Get("b"); err != nil || value != &stdout {
+		t.Errorf("Get b - received: %v %v - expected: %v", value, err, &stdout)
+	}
+	if value, err := streamsEnv.Get("a"); err != nil || value != 1 {
+		t.Errorf("Get a - received: %v %v - expected: %v", value, err, 1)
+	}
+	if _, err := streamsEnv.Get("d"); err == nil {
+		t.Errorf("Get d - received: %v - expected: undefined symbol error", err)
+	}
+	if err = streamsEnv.Set("b", 5); err != ErrReadOnly {
+		t.Errorf("Set - received: %v - expected: %v", err, ErrReadOnly)
+	}
+
+	if deterministicEnv, err := pack.EnvWithDeterministicValues(streamsEnv, time.Now, nil); err != nil || deterministicEnv != streamsEnv {
+		t.Errorf("EnvWithDeterministicValues - received: %p %v - expected: %p", deterministicEnv, err, streamsEnv)
+	}
+	now := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	pack.DeterministicValues = func(clock func() time.Time, random *rand.Rand) map[string]reflect.Value {
+		return map[string]reflect.Value{"a": reflect.ValueOf(clock()), "d": reflect.ValueOf(random)}
+	}
+	deterministicEnv, err := pack.EnvWithDeterministicValues(streamsEnv, func() time.Time { return now }, nil)
+	if err != nil {
+		t.Fatal("EnvWithDeterministicValues error:", err)
+	}
+	if value, err := deterministicEnv.Get("a"); err != nil || value != now {
+		t.Errorf("Get a - received: %v %v - expected: %v", value, err, now)
+	}
+	if value, err := deterministicEnv.Get("b"); err != nil || value != &stdout {
+		t.Errorf("Get b - received: %v %v - expected: %v", value, err, &stdout)
+	}
+	if _, err := deterministicEnv.Get("d"); err == nil {
+		t.Errorf("Get d - received: %v - expected: undefined symbol error", err)
+	}
+	if err = deterministicEnv.Set("a", 5); err != ErrReadOnly {
+		t.Errorf("Set - received: %v - expected: %v", err, ErrReadOnly)
+	}
+
+	if packEnv.RaisesErrors() || deterministicEnv.RaisesErrors() {
+		t.Errorf("RaisesErrors - received: %v %v - expected: %v", packEnv.RaisesErrors(), deterministicEnv.RaisesErrors(), false)
+	}
+	pack = &Package{Values: map[string]reflect.Value{"a": reflect.ValueOf(1)}, RaiseErrors: true, StreamValues: pack.StreamValues}
+	packEnv, err = pack.Env()
+	if err != nil {
+		t.Fatal("Env error:", err)
+	}
+	streamsEnv, err = pack.EnvWithStreams(&stdout, nil, nil)
+	if err != nil {
+		t.Fatal("EnvWithStreams error:", err)
+	}
+	if !packEnv.RaisesErrors() || !streamsEnv.RaisesErrors() || packEnv.NewEnv().RaisesErrors() {
+		t.Errorf("RaisesErrors - received: %v %v %v - expected: %v %v %v", packEnv.RaisesErrors(), streamsEnv.RaisesErrors(), packEnv.NewEnv().RaisesErrors(), true, true, false)
+	}
+
+	pack = &Package{Values: map[string]reflect.Value{"a.b": reflect.ValueOf(1)}}
+	if _, err = pack.Env(); err == nil || err.Error() != "DefineValue error: symbol contains '.'" {
+		t.Errorf("Env error - received: %v - expected: %v", err, "DefineValue error: symbol contains '.'")
+	}
+}
@@ -27,6 +27,9 @@ func (e *Env) DefineReflectType(symbol string, reflectType reflect.Type) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
+	if e.readOnly {
+		return ErrReadOnly
+	}
 
 	e.rwMutex.Lock()
 	if e.types == nil {
@@ -2,6 +2,7 @@ package env
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
 )
@@ -21,6 +22,12 @@ func (e *Env) DefineValue(symbol string, value reflect.Value) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
+	if e.readOnly {
+		return ErrReadOnly
+	}
+	if e.isConst(symbol) {
+		return &ConstError{Symbol: symbol, Op: "redefine"}
+	}
 	e.rwMutex.Lock()
 	e.values[symbol] = value
 	e.rwMutex.Unlock()
@@ -60,12 +67,25 @@ func (e *Env) SetValue(symbol string, value reflect.Value) error {
 	_, ok := e.values[symbol]
 	e.rwMutex.RUnlock()
 	if ok {
+		if e.readOnly {
+			return ErrReadOnly
+		}
+		if e.isConst(symbol) {
+			return &ConstError{Symbol: symbol, Op: "assign to"}
+		}
 		e.rwMutex.Lock()
 		e.values[symbol] = value
 		e.rwMutex.Unlock()
 		return nil
 	}
 
+	if lookup, ok := e.externalLookup.(*fieldLookup); ok {
+		found, err := lookup.setValue(symbol, value)
+		if found {
+			return err
+		}
+	}
+
 	if e.parent == nil {
 		return fmt.Errorf("undefined symbol '%s'", symbol)
 	}
@@ -107,17 +127,37 @@ func (e *Env) GetValue(symbol string) (reflect.Value, error) {
 // delete
 
 // Delete deletes symbol in current scope.
+// Nothing is deleted in a read-only Env, and constants are not deleted, see DeleteErr.
 func (e *Env) Delete(symbol string) {
+	e.DeleteErr(symbol)
+}
+
+// DeleteErr deletes symbol in current scope.
+// Returns ErrReadOnly for a read-only Env and a ConstError for a constant.
+func (e *Env) DeleteErr(symbol string) error {
+	if e.readOnly {
+		return ErrReadOnly
+	}
+	if e.isConst(symbol) {
+		return &ConstError{Symbol: symbol, Op: "delete"}
+	}
 	e.rwMutex.Lock()
 	delete(e.values, symbol)
 	e.rwMutex.Unlock()
+	return nil
 }
 
 // DeleteGlobal deletes the first matching symbol found in current or parent scope.
+// Constants are not deleted, see DeleteGlobalErr.
 func (e *Env) DeleteGlobal(symbol string) {
+	e.DeleteGlobalErr(symbol)
+}
+
+// DeleteGlobalErr deletes the first matching symbol found in current or parent scope.
+// Returns ErrReadOnly for a read-only Env and a ConstError for a constant.
+func (e *Env) DeleteGlobalErr(symbol string) error {
 	if e.parent == nil {
-		e.Delete(symbol)
-		return
+		return e.DeleteErr(symbol)
 	}
 
 	e.rwMutex.RLock()
@@ -125,11 +165,10 @@ func (e *Env) DeleteGlobal(symbol string) {
 	e.rwMutex.RUnlock()
 
 	if ok {
-		e.Delete(symbol)
-		return
+		return e.DeleteErr(symbol)
 	}
 
-	e.parent.DeleteGlobal(symbol)
+	return e.parent.DeleteGlobalErr(symbol)
 }
 
 // Addr
@@ -159,3 +198,45 @@ func (e *Env) Addr(symbol string) (reflect.Value, error) {
 	}
 	return e.parent.Addr(symbol)
 }
+
+// IsLossyConversion returns true if converted, the conversion of the number value to another number type,
+// lost information, like 1.5 to int, 256 to uint8 and -1 to uint. NaN is only lost when converted to an integer.
+// Returns false if value or converted is not a number.
+func IsLossyConversion(value reflect.Value, converted reflect.Value) bool {
+	if !isNumberKind(value.Kind()) || !isNumberKind(converted.Kind()) || !value.CanInterface() {
+		return false
+	}
+	switch {
+	case isIntKind(value.Kind()) && value.Int() < 0 && isUintKind(converted.Kind()):
+		return true
+	case isUintKind(value.Kind()) && isIntKind(converted.Kind()) && converted.Int() < 0:
+		return true
+	case isFloatKind(value.Kind()) && math.IsNaN(value.Float()):
+		return !isFloatKind(converted.Kind())
+	}
+	return converted.Convert(value.Type()).Interface() != value.Interface()
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || isFloatKind(kind)
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
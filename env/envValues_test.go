@@ -2,6 +2,7 @@ package env
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"sync"
 	"testing"
@@ -785,3 +786,36 @@ func BenchmarkSet(b *testing.B) {
 		b.Errorf("Get error: %v", err)
 	}
 }
+
+func TestIsLossyConversion(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		to       interface{}
+		expected bool
+	}{
+		{value: int64(1), to: int8(0), expected: false},
+		{value: int64(256), to: uint8(0), expected: true},
+		{value: int64(-1), to: uint(0), expected: true},
+		{value: uint64(math.MaxUint64), to: int64(0), expected: true},
+		{value: 1.5, to: int(0), expected: true},
+		{value: 2.0, to: int(0), expected: false},
+		{value: 0.1, to: float32(0), expected: true},
+		{value: 0.5, to: float32(0), expected: false},
+		{value: math.NaN(), to: float32(0), expected: false},
+		{value: math.NaN(), to: int64(0), expected: true},
+		{value: int64(1<<53 + 1), to: float64(0), expected: true},
+		{value: "1", to: "", expected: false},
+		{value: int64(65), to: "", expected: false},
+	}
+	for _, test := range tests {
+		value := reflect.ValueOf(test.value)
+		toType := reflect.TypeOf(test.to)
+		if !value.Type().ConvertibleTo(toType) {
+			t.Fatalf("ConvertibleTo %T %T - received: %v - expected: %v", test.value, test.to, false, true)
+		}
+		lossy := IsLossyConversion(value, value.Convert(toType))
+		if lossy != test.expected {
+			t.Errorf("IsLossyConversion %T %v to %T - received: %v - expected: %v", test.value, test.value, test.to, lossy, test.expected)
+		}
+	}
+}
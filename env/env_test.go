@@ -41,6 +41,30 @@ string = string
 	}
 }
 
+func TestSymbols(t *testing.T) {
+	t.Parallel()
+
+	env := NewEnv()
+	env.Define("b", "b")
+	env.Define("a", "a")
+	env.DefineType("c", "c")
+	child := env.NewEnv()
+	child.Define("d", "d")
+	child.Define("a", "a")
+	child.DefineType("e", "e")
+
+	symbols := env.Symbols()
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(symbols, expected) {
+		t.Errorf("received: %v - expected: %v", symbols, expected)
+	}
+	symbols = child.Symbols()
+	expected = []string{"a", "d"}
+	if !reflect.DeepEqual(symbols, expected) {
+		t.Errorf("received: %v - expected: %v", symbols, expected)
+	}
+}
+
 func TestGetEnvFromPath(t *testing.T) {
 	t.Parallel()
 
@@ -392,3 +416,33 @@ func TestDeepCopy(t *testing.T) {
 		t.Errorf("copy parent was modified")
 	}
 }
+
+func TestNewEnvFromMap(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]interface{}{"a": "a", "b": nil}
+	env := NewEnvFromMap(values)
+
+	if v, e := env.Get("a"); e != nil || v != "a" {
+		t.Errorf("Get a - received: %v, %v - expected: %v", v, e, "a")
+	}
+	if v, e := env.Get("b"); e != nil || v != nil {
+		t.Errorf("Get b - received: %v, %v - expected: %v", v, e, nil)
+	}
+	if _, e := env.Get("c"); e == nil || e.Error() != "undefined symbol 'c'" {
+		t.Errorf("Get c error - received: %v - expected: %v", e, "undefined symbol 'c'")
+	}
+
+	values["c"] = "c"
+	if v, e := env.Get("c"); e != nil || v != "c" {
+		t.Errorf("Get c - received: %v, %v - expected: %v", v, e, "c")
+	}
+
+	env.Define("a", "b")
+	if v, e := env.Get("a"); e != nil || v != "b" {
+		t.Errorf("Get a - received: %v, %v - expected: %v", v, e, "b")
+	}
+	if values["a"] != "a" {
+		t.Errorf("map was modified")
+	}
+}
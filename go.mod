@@ -2,4 +2,8 @@ module github.com/mattn/anko
 
 go 1.13
 
-require github.com/rotisserie/eris v0.5.4
+require (
+	github.com/peterh/liner v1.2.2
+	github.com/rotisserie/eris v0.5.4
+	golang.org/x/sys v0.7.0 // indirect
+)
@@ -34,17 +34,20 @@ func writeStderr(s string) {
 	result.Set("scrollTop", result.Get("scrollHeight").Int())
 }
 
+// resultWriter writes script output to the result element
+type resultWriter func(s string)
+
+func (w resultWriter) Write(p []byte) (int, error) {
+	w(string(p))
+	return len(p), nil
+}
+
 func main() {
 	env := vm.NewEnv()
 	core.Import(env)
 	packages.DefineImport(env)
 
-	env.Define("print", func(a ...interface{}) {
-		writeStdout(fmt.Sprint(a...))
-	})
-	env.Define("printf", func(a string, b ...interface{}) {
-		writeStdout(fmt.Sprintf(a, b...))
-	})
+	options := &vm.Options{Stdout: resultWriter(writeStdout), Stderr: resultWriter(writeStderr)}
 
 	var following bool
 	var source string
@@ -107,7 +110,7 @@ func main() {
 			var v interface{}
 
 			if err == nil {
-				v, err = vm.Run(stmts, env)
+				v, err = vm.Run(env, options, stmts)
 			}
 			if err != nil {
 				if e, ok := err.(*vm.Error); ok {
@@ -0,0 +1,417 @@
+package packages
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValueEncoder writes script values as JSON values to an output stream, see Encode
+type ValueEncoder struct {
+	encoder *json.Encoder
+}
+
+// ValueDecoder reads JSON values from an input stream as script values, see Decode
+type ValueDecoder struct {
+	decoder *json.Decoder
+}
+
+// orderedMap is the interface of maps that keep the order of their keys, like vm.OrderedMap
+type orderedMap interface {
+	Keys() []interface{}
+	Get(key interface{}) (interface{}, bool)
+}
+
+// jsonObject is a JSON object that keeps the order of its members, like the fields of a struct
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	name  string
+	value interface{}
+}
+
+// jsonEncodeState has the maps, pointers and slices being encoded to detect cycles like encoding/json does
+type jsonEncodeState struct {
+	visiting map[jsonPointer]struct{}
+}
+
+// jsonPointer identifies a map, pointer or slice, slices with the same data but another length are different values
+type jsonPointer struct {
+	ptr    uintptr
+	typ    reflect.Type
+	length int
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// jsonEncode returns the JSON encoding of a script value.
+// Maps with keys that are not strings, like map[interface]interface, are encoded with the keys formatted as strings,
+// different keys formatted as the same string, like 1 and "1", are an error.
+func jsonEncode(v interface{}) (string, error) {
+	value, err := jsonValue(v)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+// jsonEncodeIndent is like jsonEncode but indents the output like json.MarshalIndent
+func jsonEncodeIndent(v interface{}, prefix string, indent string) (string, error) {
+	value, err := jsonValue(v)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(value, prefix, indent)
+	return string(data), err
+}
+
+// jsonDecode returns the script value of JSON data.
+// Objects are decoded as map[interface]interface and arrays as []interface.
+// Numbers are decoded as int64 when they are integers that fit, else as float64.
+func jsonDecode(data string) (interface{}, error) {
+	decoder := newJSONDecoder(strings.NewReader(data))
+	var v interface{}
+	err := decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid character after top-level value")
+	}
+	return scriptValue(v), nil
+}
+
+// NewValueEncoder returns a new ValueEncoder that writes to w
+func NewValueEncoder(w io.Writer) *ValueEncoder {
+	return &ValueEncoder{encoder: json.NewEncoder(w)}
+}
+
+// Encode writes the JSON encoding of a script value to the stream followed by a newline
+func (enc *ValueEncoder) Encode(v interface{}) error {
+	value, err := jsonValue(v)
+	if err != nil {
+		return err
+	}
+	return enc.encoder.Encode(value)
+}
+
+// SetIndent makes the encoder indent each value like json.MarshalIndent
+func (enc *ValueEncoder) SetIndent(prefix string, indent string) {
+	enc.encoder.SetIndent(prefix, indent)
+}
+
+// SetEscapeHTML sets if the problematic HTML characters should be escaped inside JSON strings
+func (enc *ValueEncoder) SetEscapeHTML(on bool) {
+	enc.encoder.SetEscapeHTML(on)
+}
+
+// NewValueDecoder returns a new ValueDecoder that reads from r
+func NewValueDecoder(r io.Reader) *ValueDecoder {
+	return &ValueDecoder{decoder: newJSONDecoder(r)}
+}
+
+// Decode reads the next JSON value from the stream and returns it as script value, io.EOF at the end of the stream
+func (dec *ValueDecoder) Decode() (interface{}, error) {
+	var v interface{}
+	err := dec.decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return scriptValue(v), nil
+}
+
+// More returns true if there is another value in the current array or object being parsed
+func (dec *ValueDecoder) More() bool {
+	return dec.decoder.More()
+}
+
+func newJSONDecoder(r io.Reader) *json.Decoder {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	return decoder
+}
+
+// MarshalJSON encodes the members in order
+func (object jsonObject) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, member := range object {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		name, err := json.Marshal(member.name)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		value, err := json.Marshal(member.value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// jsonValue returns a value that encoding/json can encode like the script value v.
+// Values that marshal themselves, like time.Time and *big.Int, are kept as they are.
+// Ordered maps are encoded as objects with the members in the order of their keys.
+// Returns a *json.UnsupportedValueError if a value contains itself.
+func jsonValue(v interface{}) (interface{}, error) {
+	state := &jsonEncodeState{visiting: make(map[jsonPointer]struct{})}
+	return state.value(reflect.ValueOf(v))
+}
+
+// value returns a value that encoding/json can encode like rv, see jsonValue
+func (state *jsonEncodeState) value(rv reflect.Value) (interface{}, error) {
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil, nil
+	}
+	if rv.Type().Implements(jsonMarshalerType) || rv.Type().Implements(textMarshalerType) {
+		if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
+			return nil, nil
+		}
+		return rv.Interface(), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return state.value(rv.Elem())
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		pointer, err := state.enter(rv)
+		if err != nil {
+			return nil, err
+		}
+		defer delete(state.visiting, pointer)
+		if m, ok := rv.Interface().(orderedMap); ok {
+			keys := m.Keys()
+			object := make(jsonObject, len(keys))
+			names := make(map[string]struct{}, len(keys))
+			for i := range keys {
+				item, _ := m.Get(keys[i])
+				value, err := state.value(reflect.ValueOf(item))
+				if err != nil {
+					return nil, err
+				}
+				object[i] = jsonMember{name: jsonKey(reflect.ValueOf(&keys[i]).Elem()), value: value}
+				if _, ok := names[object[i].name]; ok {
+					return nil, duplicateMemberError(rv, object[i].name)
+				}
+				names[object[i].name] = struct{}{}
+			}
+			return object, nil
+		}
+		return state.value(rv.Elem())
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		pointer, err := state.enter(rv)
+		if err != nil {
+			return nil, err
+		}
+		defer delete(state.visiting, pointer)
+		object := make(map[string]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			value, err := state.value(rv.MapIndex(key))
+			if err != nil {
+				return nil, err
+			}
+			name := jsonKey(key)
+			if _, ok := object[name]; ok {
+				return nil, duplicateMemberError(rv, name)
+			}
+			object[name] = value
+		}
+		return object, nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// base64 like encoding/json does
+			return rv.Interface(), nil
+		}
+		pointer, err := state.enter(rv)
+		if err != nil {
+			return nil, err
+		}
+		defer delete(state.visiting, pointer)
+		fallthrough
+	case reflect.Array:
+		array := make([]interface{}, rv.Len())
+		for i := range array {
+			value, err := state.value(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			array[i] = value
+		}
+		return array, nil
+	case reflect.Struct:
+		return state.structValue(rv, nil)
+	}
+	return rv.Interface(), nil
+}
+
+// enter adds a map, pointer or slice to the values being encoded,
+// returns a *json.UnsupportedValueError if it is already being encoded
+func (state *jsonEncodeState) enter(rv reflect.Value) (jsonPointer, error) {
+	pointer := jsonPointer{ptr: rv.Pointer(), typ: rv.Type()}
+	if rv.Kind() == reflect.Slice {
+		pointer.length = rv.Len()
+	}
+	if _, ok := state.visiting[pointer]; ok {
+		return pointer, &json.UnsupportedValueError{Value: rv, Str: fmt.Sprintf("encountered a cycle via %s", rv.Type())}
+	}
+	state.visiting[pointer] = struct{}{}
+	return pointer, nil
+}
+
+// duplicateMemberError returns the error of a map with different keys that have the same JSON object member name,
+// like 1 and "1", because one of them would be lost
+func duplicateMemberError(rv reflect.Value, name string) error {
+	return &json.UnsupportedValueError{Value: rv, Str: fmt.Sprintf("keys of %v with the same member name %q", rv.Type(), name)}
+}
+
+// jsonKey returns the JSON object member name of a map key
+func jsonKey(key reflect.Value) string {
+	if key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	if key.Type().Implements(textMarshalerType) {
+		text, err := key.Interface().(encoding.TextMarshaler).MarshalText()
+		if err == nil {
+			return string(text)
+		}
+	}
+	switch key.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(key.Uint(), 10)
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+// structValue appends the exported fields of a struct to object using the json struct tags.
+// Fields of embedded structs without a tag are added like fields of the struct.
+func (state *jsonEncodeState) structValue(rv reflect.Value, object jsonObject) (jsonObject, error) {
+	if object == nil {
+		object = jsonObject{}
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if index := strings.IndexByte(tag, ','); index >= 0 {
+			name, options = tag[:index], tag[index+1:]
+		}
+		value := rv.Field(i)
+
+		if field.Anonymous && name == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				pointer, err := state.enter(embedded)
+				if err != nil {
+					return nil, err
+				}
+				defer delete(state.visiting, pointer)
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				var err error
+				object, err = state.structValue(embedded, object)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.Contains(","+options+",", ",omitempty,") && isEmptyJSONValue(value) {
+			continue
+		}
+		member, err := state.value(value)
+		if err != nil {
+			return nil, err
+		}
+		object = append(object, jsonMember{name: name, value: member})
+	}
+	return object, nil
+}
+
+// isEmptyJSONValue returns true for the values that the omitempty option omits
+func isEmptyJSONValue(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool:
+		return !rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// scriptValue converts a value decoded with UseNumber to script types
+func scriptValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(value))
+		for key, item := range value {
+			m[key] = scriptValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range value {
+			value[i] = scriptValue(item)
+		}
+		return value
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+package packages
+
+import (
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// The values written for anko are registered here in the extra, stream and deterministic package maps,
+// which the bindings files generated by anko-package-gen never set, so the bindings can be regenerated
+// and the order of the init functions does not matter.
+func init() {
+	env.PackageExtraValues["encoding/json"] = map[string]reflect.Value{
+		"Decode":          reflect.ValueOf(jsonDecode),
+		"Encode":          reflect.ValueOf(jsonEncode),
+		"EncodeIndent":    reflect.ValueOf(jsonEncodeIndent),
+		"NewValueDecoder": reflect.ValueOf(NewValueDecoder),
+		"NewValueEncoder": reflect.ValueOf(NewValueEncoder),
+	}
+
+	env.PackageStreamValues["fmt"] = fmtStreamValues
+	env.PackageStreamValues["os"] = osStreamValues
+
+	env.PackageDeterministicValues["math/rand"] = mathRandDeterministicValues
+	env.PackageDeterministicValues["time"] = timeDeterministicValues
+
+	env.PackageExtraTypes["sort"] = map[string]reflect.Type{
+		"SortFuncsStruct": reflect.TypeOf(&SortFuncsStruct{}),
+	}
+}
@@ -0,0 +1,19 @@
+package packages
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// fmtStreamValues returns the fmt functions that print to stdout and scan stdin of a VM run
+func fmtStreamValues(stdout io.Writer, stderr io.Writer, stdin io.Reader) map[string]reflect.Value {
+	return map[string]reflect.Value{
+		"Print":   reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fprint(stdout, a...) }),
+		"Printf":  reflect.ValueOf(func(format string, a ...interface{}) (int, error) { return fmt.Fprintf(stdout, format, a...) }),
+		"Println": reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fprintln(stdout, a...) }),
+		"Scan":    reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fscan(stdin, a...) }),
+		"Scanf":   reflect.ValueOf(func(format string, a ...interface{}) (int, error) { return fmt.Fscanf(stdin, format, a...) }),
+		"Scanln":  reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fscanln(stdin, a...) }),
+	}
+}
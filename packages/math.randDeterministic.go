@@ -0,0 +1,26 @@
+package packages
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// mathRandDeterministicValues returns the math/rand functions that use the random numbers of a deterministic VM run
+func mathRandDeterministicValues(now func() time.Time, random *rand.Rand) map[string]reflect.Value {
+	return map[string]reflect.Value{
+		"ExpFloat64":  reflect.ValueOf(random.ExpFloat64),
+		"Float32":     reflect.ValueOf(random.Float32),
+		"Float64":     reflect.ValueOf(random.Float64),
+		"Int":         reflect.ValueOf(random.Int),
+		"Int31":       reflect.ValueOf(random.Int31),
+		"Int31n":      reflect.ValueOf(random.Int31n),
+		"Int63":       reflect.ValueOf(random.Int63),
+		"Int63n":      reflect.ValueOf(random.Int63n),
+		"Intn":        reflect.ValueOf(random.Intn),
+		"NormFloat64": reflect.ValueOf(random.NormFloat64),
+		"Perm":        reflect.ValueOf(random.Perm),
+		"Seed":        reflect.ValueOf(random.Seed),
+		"Uint32":      reflect.ValueOf(random.Uint32),
+	}
+}
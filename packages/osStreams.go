@@ -0,0 +1,15 @@
+package packages
+
+import (
+	"io"
+	"reflect"
+)
+
+// osStreamValues returns the streams of a VM run as os.Stdout, os.Stderr and os.Stdin
+func osStreamValues(stdout io.Writer, stderr io.Writer, stdin io.Reader) map[string]reflect.Value {
+	return map[string]reflect.Value{
+		"Stderr": reflect.ValueOf(stderr),
+		"Stdin":  reflect.ValueOf(stdin),
+		"Stdout": reflect.ValueOf(stdout),
+	}
+}
@@ -0,0 +1,16 @@
+package packages
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// timeDeterministicValues returns the time functions that use the clock of a deterministic VM run
+func timeDeterministicValues(now func() time.Time, random *rand.Rand) map[string]reflect.Value {
+	return map[string]reflect.Value{
+		"Now":   reflect.ValueOf(now),
+		"Since": reflect.ValueOf(func(t time.Time) time.Duration { return now().Sub(t) }),
+		"Until": reflect.ValueOf(func(t time.Time) time.Duration { return t.Sub(now()) }),
+	}
+}
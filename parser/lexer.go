@@ -4,7 +4,9 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -25,6 +27,8 @@ type Error struct {
 	Pos      ast.Position
 	Filename string
 	Fatal    bool
+	// Incomplete is true when the source ended before the statement did, so more source could complete it
+	Incomplete bool
 }
 
 // Error returns the parse error message.
@@ -45,6 +49,7 @@ var opName = map[string]int{
 	"func":     FUNC,
 	"return":   RETURN,
 	"var":      VAR,
+	"const":    CONST,
 	"throw":    THROW,
 	"if":       IF,
 	"for":      FOR,
@@ -75,6 +80,16 @@ var opName = map[string]int{
 	"import":   IMPORT,
 }
 
+// Keywords returns the sorted keywords of the language.
+func Keywords() []string {
+	keywords := make([]string, 0, len(opName))
+	for keyword := range opName {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	return keywords
+}
+
 var (
 	nilValue   = reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
 	trueValue  = reflect.ValueOf(true)
@@ -323,7 +338,7 @@ retry:
 				tok = int(ch)
 				lit = string(ch)
 			}
-		case '\n', '(', ')', ':', ';', '%', '{', '}', '[', ']', ',', '^':
+		case '\n', '(', ')', ':', ';', '%', '{', '}', '[', ']', ',', '^', '@':
 			tok = int(ch)
 			lit = string(ch)
 		default:
@@ -504,7 +519,7 @@ func (s *Scanner) scanRawString(l rune) (string, error) {
 	for {
 		s.next()
 		if s.peek() == EOF {
-			return "", errors.New("unexpected EOF")
+			return "", io.ErrUnexpectedEOF
 		}
 		if s.peek() == l {
 			s.next()
@@ -526,7 +541,7 @@ eos:
 		case EOL:
 			return "", errors.New("unexpected EOL")
 		case EOF:
-			return "", errors.New("unexpected EOF")
+			return "", io.ErrUnexpectedEOF
 		case l:
 			s.next()
 			break eos
@@ -561,6 +576,7 @@ eos:
 // Lexer provides interface to parse codes.
 type Lexer struct {
 	s    *Scanner
+	tok  int
 	lit  string
 	pos  ast.Position
 	e    error
@@ -571,10 +587,11 @@ type Lexer struct {
 func (l *Lexer) Lex(lval *yySymType) int {
 	tok, lit, pos, err := l.s.Scan()
 	if err != nil {
-		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true, Incomplete: err == io.ErrUnexpectedEOF}
 	}
 	lval.tok = ast.Token{Tok: tok, Lit: lit}
 	lval.tok.SetPosition(pos)
+	l.tok = tok
 	l.lit = lit
 	l.pos = pos
 	return tok
@@ -582,7 +599,7 @@ func (l *Lexer) Lex(lval *yySymType) int {
 
 // Error sets parse error.
 func (l *Lexer) Error(msg string) {
-	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false}
+	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false, Incomplete: l.tok == EOF}
 }
 
 // Parse provides way to parse the code using Scanner.
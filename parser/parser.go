@@ -11,7 +11,23 @@ import (
 	"github.com/mattn/anko/ast"
 )
 
-//line parser.go.y:45
+// typedIdents are identifiers with optional type annotations
+type typedIdents struct {
+	names []string
+	types []*ast.TypeStruct
+}
+
+// annotations returns the types, or nil when no identifier has a type
+func (idents typedIdents) annotations() []*ast.TypeStruct {
+	for _, t := range idents.types {
+		if t != nil {
+			return idents.types
+		}
+	}
+	return nil
+}
+
+//line parser.go.y:71
 type yySymType struct {
 	yys int
 	tok ast.Token
@@ -22,6 +38,8 @@ type yySymType struct {
 	stmt_var_or_lets    ast.Stmt
 	stmt_var            ast.Stmt
 	stmt_lets           ast.Stmt
+	stmt_catches        []ast.Stmt
+	stmt_catch          ast.Stmt
 	stmt_if             ast.Stmt
 	stmt_for            ast.Stmt
 	stmt_switch         ast.Stmt
@@ -32,12 +50,16 @@ type yySymType struct {
 	exprs                []ast.Expr
 	expr                 ast.Expr
 	expr_idents          []string
+	expr_typed_idents    typedIdents
+	type_datas           []*ast.TypeStruct
 	type_data            *ast.TypeStruct
 	type_data_struct     *ast.TypeStruct
 	slice_count          int
 	expr_member_or_ident ast.Expr
 	expr_member          *ast.MemberExpr
 	expr_ident           *ast.IdentExpr
+	expr_try_call        ast.Expr
+	expr_try_callee      ast.Expr
 	expr_literals        ast.Expr
 	expr_map             *ast.MapExpr
 	expr_slice           ast.Expr
@@ -107,7 +129,8 @@ const DELETE = 57397
 const CLOSE = 57398
 const MAP = 57399
 const IMPORT = 57400
-const UNARY = 57401
+const CONST = 57401
+const UNARY = 57402
 
 var yyToknames = [...]string{
 	"$end",
@@ -168,6 +191,7 @@ var yyToknames = [...]string{
 	"CLOSE",
 	"MAP",
 	"IMPORT",
+	"CONST",
 	"'='",
 	"':'",
 	"'?'",
@@ -190,6 +214,7 @@ var yyToknames = [...]string{
 	"';'",
 	"'['",
 	"']'",
+	"'@'",
 	"'.'",
 	"'!'",
 	"'\\n'",
@@ -201,707 +226,787 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.go.y:1090
+//line parser.go.y:1268
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 2,
-	52, 57,
-	59, 57,
-	77, 57,
-	78, 5,
-	-2, 1,
-	-1, 23,
-	77, 58,
-	-2, 26,
-	-1, 27,
-	16, 95,
-	-2, 57,
-	-1, 67,
-	52, 57,
-	59, 57,
-	77, 57,
-	-2, 5,
-	-1, 120,
-	16, 96,
-	77, 96,
-	-2, 112,
-	-1, 124,
-	4, 107,
-	48, 107,
-	49, 107,
-	57, 107,
-	-2, 69,
-	-1, 267,
-	74, 181,
-	80, 181,
-	-2, 173,
-	-1, 288,
-	74, 181,
-	-2, 173,
-	-1, 292,
-	1, 60,
-	8, 60,
-	45, 60,
-	46, 60,
-	52, 60,
-	59, 60,
-	60, 60,
-	74, 60,
-	76, 60,
-	77, 60,
-	78, 60,
-	80, 60,
-	83, 60,
-	-2, 110,
-	-1, 296,
-	1, 17,
-	45, 17,
-	46, 17,
-	74, 17,
-	78, 17,
-	83, 17,
-	-2, 74,
-	-1, 298,
-	1, 19,
-	45, 19,
-	46, 19,
-	74, 19,
-	78, 19,
-	83, 19,
+	-1, 3,
+	52, 64,
+	60, 64,
+	78, 64,
+	-2, 4,
+	-1, 7,
+	52, 64,
+	60, 64,
+	78, 64,
+	-2, 4,
+	-1, 24,
+	78, 65,
+	-2, 23,
+	-1, 28,
+	16, 104,
+	-2, 64,
+	-1, 129,
+	16, 105,
+	78, 105,
+	-2, 139,
+	-1, 133,
+	4, 127,
+	48, 127,
+	49, 127,
+	57, 127,
 	-2, 76,
-	-1, 328,
-	74, 179,
-	80, 179,
-	-2, 174,
-	-1, 348,
+	-1, 218,
+	75, 4,
+	79, 4,
+	85, 4,
+	-2, 64,
+	-1, 286,
+	78, 65,
+	-2, 23,
+	-1, 299,
+	75, 207,
+	81, 207,
+	-2, 199,
+	-1, 324,
+	1, 67,
+	8, 67,
+	45, 67,
+	46, 67,
+	52, 67,
+	60, 67,
+	61, 67,
+	75, 67,
+	77, 67,
+	78, 67,
+	79, 67,
+	81, 67,
+	85, 67,
+	-2, 130,
+	-1, 325,
+	75, 207,
+	-2, 199,
+	-1, 337,
+	1, 14,
+	45, 14,
+	46, 14,
+	75, 14,
+	79, 14,
+	85, 14,
+	-2, 82,
+	-1, 339,
 	1, 16,
 	45, 16,
 	46, 16,
-	74, 16,
-	78, 16,
-	83, 16,
-	-2, 73,
-	-1, 349,
-	1, 18,
-	45, 18,
-	46, 18,
-	74, 18,
-	78, 18,
-	83, 18,
-	-2, 75,
+	75, 16,
+	79, 16,
+	85, 16,
+	-2, 84,
+	-1, 370,
+	75, 205,
+	81, 205,
+	-2, 200,
+	-1, 393,
+	1, 13,
+	45, 13,
+	46, 13,
+	75, 13,
+	79, 13,
+	85, 13,
+	-2, 81,
+	-1, 394,
+	1, 15,
+	45, 15,
+	46, 15,
+	75, 15,
+	79, 15,
+	85, 15,
+	-2, 83,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3907
+const yyLast = 4408
 
 var yyAct = [...]int{
-	71, 268, 33, 23, 232, 320, 321, 36, 116, 323,
-	322, 5, 8, 288, 377, 72, 8, 267, 76, 8,
-	8, 124, 386, 8, 8, 127, 281, 114, 117, 121,
-	214, 214, 282, 283, 84, 135, 130, 214, 85, 214,
-	87, 1, 331, 214, 207, 286, 214, 8, 7, 329,
-	220, 137, 151, 327, 134, 69, 49, 145, 152, 153,
-	154, 155, 156, 213, 217, 202, 142, 373, 23, 149,
-	70, 214, 143, 404, 340, 349, 348, 334, 162, 163,
-	325, 166, 167, 168, 169, 297, 171, 173, 303, 175,
-	149, 210, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 136, 159, 69, 295, 206,
-	448, 326, 203, 148, 234, 160, 203, 211, 141, 274,
-	147, 209, 130, 130, 140, 130, 86, 139, 223, 225,
-	226, 170, 203, 130, 130, 233, 130, 265, 149, 236,
-	123, 138, 447, 298, 149, 215, 216, 200, 218, 144,
-	89, 90, 100, 101, 78, 245, 227, 228, 77, 231,
-	443, 248, 438, 252, 437, 69, 435, 428, 427, 6,
-	423, 422, 212, 128, 346, 68, 296, 149, 97, 98,
-	99, 102, 125, 222, 367, 84, 421, 275, 149, 85,
-	238, 87, 419, 255, 235, 86, 259, 246, 262, 410,
-	128, 409, 126, 164, 405, 264, 203, 240, 241, 266,
-	130, 122, 126, 211, 401, 278, 397, 132, 133, 89,
-	90, 233, 229, 395, 287, 285, 131, 444, 291, 249,
-	149, 253, 394, 272, 299, 292, 257, 129, 302, 393,
-	69, 390, 304, 347, 132, 133, 385, 356, 134, 343,
-	311, 315, 317, 131, 84, 308, 301, 293, 85, 254,
-	87, 239, 442, 165, 129, 408, 388, 372, 335, 9,
-	371, 324, 219, 158, 339, 134, 74, 341, 441, 130,
-	345, 290, 323, 322, 436, 201, 294, 79, 430, 333,
-	312, 310, 230, 10, 284, 271, 146, 174, 354, 237,
-	73, 4, 342, 2, 62, 67, 63, 66, 69, 363,
-	64, 65, 126, 47, 368, 366, 365, 330, 46, 126,
-	45, 119, 374, 44, 130, 43, 130, 30, 50, 381,
-	29, 384, 351, 332, 319, 387, 22, 157, 21, 20,
-	25, 355, 24, 3, 391, 357, 358, 375, 360, 378,
-	0, 0, 0, 256, 0, 0, 370, 0, 263, 0,
-	0, 0, 0, 270, 0, 0, 0, 69, 0, 273,
-	369, 412, 0, 126, 414, 0, 0, 0, 126, 389,
-	0, 0, 269, 126, 0, 0, 0, 0, 0, 126,
-	0, 396, 0, 398, 399, 0, 0, 0, 0, 402,
-	0, 0, 269, 406, 407, 0, 0, 233, 434, 0,
-	0, 433, 0, 0, 0, 0, 0, 237, 0, 0,
-	418, 0, 0, 130, 0, 440, 0, 0, 201, 0,
-	0, 0, 424, 0, 0, 425, 426, 328, 344, 0,
-	429, 0, 0, 0, 0, 0, 439, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 328, 120,
-	52, 53, 0, 0, 31, 0, 48, 0, 0, 364,
-	0, 0, 0, 0, 445, 0, 446, 0, 39, 54,
-	55, 56, 376, 0, 201, 0, 201, 0, 0, 126,
-	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 126, 0, 0, 40, 57, 0, 0, 37,
-	0, 0, 41, 38, 89, 90, 100, 101, 0, 0,
-	51, 0, 59, 61, 0, 0, 60, 411, 115, 0,
-	34, 0, 201, 118, 32, 0, 416, 58, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 126, 0, 84,
-	0, 0, 0, 85, 0, 87, 126, 0, 0, 86,
-	106, 107, 111, 109, 113, 112, 0, 0, 0, 0,
-	83, 0, 0, 0, 0, 91, 92, 94, 95, 96,
-	93, 0, 0, 89, 90, 100, 101, 0, 0, 0,
-	0, 0, 0, 0, 88, 81, 0, 0, 0, 0,
-	0, 0, 80, 269, 82, 108, 110, 103, 104, 105,
-	0, 97, 98, 99, 102, 0, 204, 0, 84, 0,
-	0, 0, 85, 0, 87, 35, 52, 53, 0, 0,
-	31, 13, 48, 14, 26, 0, 27, 0, 0, 0,
-	0, 0, 0, 0, 39, 54, 55, 56, 0, 15,
-	16, 0, 0, 0, 0, 0, 0, 0, 0, 11,
-	12, 0, 0, 0, 0, 28, 0, 0, 17, 0,
-	0, 40, 57, 0, 0, 37, 18, 19, 41, 38,
-	0, 0, 0, 0, 0, 0, 51, 0, 59, 61,
-	0, 0, 60, 0, 42, 0, 34, 0, 0, 0,
-	32, 0, 0, 58, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 382, 383, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 380, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 379, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	353, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	352, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 338, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 0, 85, 337, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 307, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 306, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 276, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 251, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 250, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 242, 243, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 81, 0,
-	0, 0, 0, 0, 0, 80, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 432, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 431, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 420, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	417, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 415, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 413, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 403, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 400, 0, 84,
-	0, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	392, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 361,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 359, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 350, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 318, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 313, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 309,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 300, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 289, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	280, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 279, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 260, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 244, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	221, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 208, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 199,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 161, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	35, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 39,
-	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 40, 57, 0, 85,
-	37, 87, 0, 41, 38, 0, 0, 0, 0, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 42,
-	0, 34, 35, 52, 53, 32, 336, 31, 58, 0,
+	74, 78, 34, 257, 24, 251, 4, 361, 24, 9,
+	362, 6, 300, 5, 37, 72, 76, 153, 70, 85,
+	325, 365, 231, 70, 169, 371, 424, 6, 123, 126,
+	130, 364, 363, 6, 299, 237, 143, 138, 133, 135,
+	145, 6, 6, 8, 314, 315, 1, 93, 433, 6,
+	231, 94, 471, 174, 96, 160, 142, 231, 231, 373,
+	175, 231, 162, 163, 164, 165, 166, 313, 318, 155,
+	231, 6, 230, 231, 234, 172, 224, 150, 480, 481,
+	136, 231, 173, 151, 176, 136, 70, 179, 180, 158,
+	183, 184, 185, 186, 369, 188, 190, 382, 192, 420,
+	394, 193, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 140, 141, 253, 171, 223, 140,
+	141, 338, 219, 139, 393, 263, 228, 249, 139, 392,
+	138, 138, 95, 138, 250, 137, 240, 242, 243, 226,
+	137, 138, 138, 453, 138, 250, 142, 417, 255, 390,
+	81, 142, 258, 368, 250, 336, 98, 99, 109, 110,
+	158, 217, 262, 157, 391, 389, 376, 232, 233, 95,
+	235, 156, 276, 334, 367, 344, 81, 132, 244, 245,
+	283, 248, 502, 261, 220, 106, 107, 108, 111, 158,
+	339, 158, 93, 98, 99, 80, 94, 264, 227, 96,
+	144, 332, 177, 149, 259, 3, 148, 147, 306, 286,
+	287, 297, 146, 291, 87, 294, 279, 86, 501, 277,
+	80, 252, 83, 136, 337, 158, 301, 138, 492, 93,
+	228, 496, 310, 94, 495, 298, 96, 493, 258, 134,
+	437, 317, 335, 158, 479, 138, 475, 323, 83, 131,
+	474, 473, 329, 284, 301, 330, 461, 460, 289, 450,
+	326, 324, 446, 444, 304, 340, 161, 140, 141, 343,
+	333, 158, 246, 345, 443, 181, 139, 307, 158, 442,
+	296, 250, 321, 356, 358, 280, 158, 439, 137, 80,
+	43, 432, 401, 387, 52, 352, 370, 349, 342, 142,
+	377, 80, 170, 328, 285, 70, 381, 265, 484, 73,
+	458, 138, 457, 415, 301, 80, 236, 167, 388, 60,
+	383, 366, 370, 11, 412, 364, 363, 353, 170, 260,
+	218, 88, 10, 485, 385, 152, 182, 375, 351, 399,
+	71, 331, 316, 303, 154, 269, 267, 191, 384, 77,
+	408, 434, 128, 7, 66, 413, 247, 67, 411, 138,
+	68, 410, 69, 49, 256, 48, 138, 47, 138, 301,
+	46, 428, 45, 431, 70, 421, 31, 435, 396, 418,
+	419, 436, 82, 138, 79, 53, 30, 400, 187, 440,
+	374, 402, 403, 455, 405, 125, 416, 360, 23, 22,
+	21, 168, 26, 422, 25, 425, 2, 0, 0, 0,
+	138, 0, 0, 0, 0, 0, 0, 0, 463, 0,
+	438, 465, 0, 0, 0, 0, 288, 0, 470, 229,
+	468, 295, 459, 0, 0, 0, 302, 0, 0, 239,
+	0, 445, 305, 447, 448, 0, 0, 456, 0, 451,
+	0, 254, 454, 0, 0, 0, 320, 0, 258, 0,
+	489, 488, 490, 491, 0, 0, 0, 266, 0, 268,
+	0, 0, 271, 272, 138, 0, 0, 0, 138, 0,
+	0, 0, 0, 500, 0, 301, 476, 0, 0, 477,
+	478, 499, 503, 0, 482, 483, 218, 0, 0, 0,
+	95, 0, 0, 0, 0, 256, 0, 0, 0, 0,
+	0, 494, 0, 0, 0, 498, 0, 0, 0, 0,
+	0, 497, 0, 0, 98, 99, 109, 110, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 322, 112,
+	113, 114, 0, 106, 107, 108, 111, 0, 0, 0,
+	93, 218, 0, 218, 94, 0, 409, 96, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	423, 0, 36, 55, 56, 0, 0, 32, 14, 50,
+	15, 27, 0, 28, 0, 0, 0, 372, 0, 0,
+	0, 40, 57, 58, 59, 0, 16, 17, 0, 0,
+	0, 0, 0, 218, 0, 0, 12, 13, 0, 0,
+	0, 0, 29, 0, 0, 18, 0, 0, 41, 61,
+	462, 0, 38, 19, 20, 42, 39, 51, 0, 467,
+	0, 0, 0, 0, 54, 0, 63, 65, 0, 0,
+	64, 0, 60, 0, 35, 0, 0, 0, 33, 414,
+	44, 0, 62, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 90,
+	0, 0, 0, 0, 0, 0, 0, 89, 327, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 0, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 90, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 221,
+	0, 93, 0, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 0, 0, 93,
+	429, 430, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 427, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 426, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	398, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 0, 0, 0, 94,
+	397, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 380, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 0, 0, 0, 94, 379, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 0,
+	0, 93, 0, 0, 0, 94, 347, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 309, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 0, 0, 93,
+	0, 0, 0, 94, 308, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 281, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 273, 274, 0, 94,
+	0, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 90,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 0, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 80,
+	0, 93, 0, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 0, 0, 93,
+	487, 0, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 486, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 0, 0, 0, 94,
+	472, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 469, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 0, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 0,
+	0, 93, 466, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 0, 0, 93,
+	0, 0, 0, 94, 464, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 0, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 449, 0, 93, 0, 0, 0, 94,
+	0, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 0, 0, 0, 94, 441, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 406,
+	0, 93, 0, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 404, 0, 93,
+	0, 0, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 395, 0,
+	0, 94, 0, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 0, 0, 359, 94,
+	0, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 354, 0, 93, 0, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 350,
+	0, 93, 0, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 341, 0, 93,
+	0, 0, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 327, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 0, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 312, 0, 0, 94,
+	0, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 311, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 0,
+	0, 93, 0, 0, 292, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 0, 0, 93,
+	0, 0, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 275, 0,
+	0, 94, 0, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 93, 270, 0, 0, 94,
+	0, 0, 96, 95, 115, 116, 120, 118, 122, 121,
+	0, 0, 0, 0, 92, 0, 0, 0, 0, 100,
+	101, 103, 104, 105, 102, 0, 0, 98, 99, 109,
+	110, 0, 0, 0, 0, 0, 0, 0, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	117, 119, 112, 113, 114, 0, 106, 107, 108, 111,
+	0, 0, 0, 93, 238, 0, 0, 94, 0, 0,
+	96, 95, 115, 116, 120, 118, 122, 121, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 100, 101, 103,
+	104, 105, 102, 0, 0, 98, 99, 109, 110, 0,
+	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 117, 119,
+	112, 113, 114, 0, 106, 107, 108, 111, 0, 225,
+	0, 93, 0, 0, 0, 94, 0, 0, 96, 95,
+	115, 116, 120, 118, 122, 121, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 100, 101, 103, 104, 105,
+	102, 0, 0, 98, 99, 109, 110, 0, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 117, 119, 112, 113,
+	114, 0, 106, 107, 108, 111, 0, 216, 0, 93,
+	0, 0, 0, 94, 0, 0, 96, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 0,
+	0, 0, 0, 100, 101, 103, 104, 105, 102, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 0, 0,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 0, 0, 93, 0, 0,
+	0, 94, 0, 0, 96, 95, 115, 116, 120, 118,
+	122, 121, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 100, 101, 103, 104, 105, 102, 0, 0, 98,
+	99, 109, 110, 0, 0, 0, 0, 0, 0, 0,
+	97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 117, 119, 112, 113, 114, 0, 106, 107,
+	108, 111, 0, 0, 0, 178, 129, 55, 56, 94,
+	0, 32, 96, 50, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 40, 57, 58, 59, 0,
+	0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 41, 61, 0, 0, 38, 0, 0, 42,
+	39, 51, 0, 0, 0, 0, 0, 0, 54, 0,
+	63, 65, 0, 0, 64, 0, 124, 0, 35, 0,
+	0, 127, 33, 0, 44, 0, 62, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 36,
+	55, 56, 0, 0, 32, 0, 0, 0, 0, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 40, 57,
+	58, 59, 97, 0, 75, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 41, 61, 93, 0, 38,
+	0, 94, 42, 39, 96, 0, 0, 0, 0, 0,
+	0, 54, 0, 63, 65, 0, 0, 64, 0, 60,
+	0, 35, 0, 0, 0, 33, 378, 44, 0, 62,
+	36, 55, 56, 0, 0, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
+	57, 58, 59, 0, 0, 75, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 36, 55, 56, 0,
+	0, 32, 0, 0, 0, 0, 41, 61, 0, 0,
+	38, 0, 0, 42, 39, 40, 57, 58, 59, 0,
+	0, 75, 54, 0, 63, 65, 0, 0, 64, 0,
+	60, 0, 35, 0, 0, 0, 33, 346, 44, 0,
+	62, 0, 41, 61, 0, 0, 38, 0, 0, 42,
+	39, 0, 0, 0, 0, 0, 0, 0, 54, 0,
+	63, 65, 0, 0, 64, 0, 60, 0, 35, 0,
+	0, 293, 33, 0, 44, 0, 62, 95, 115, 116,
+	120, 118, 122, 121, 0, 0, 0, 0, 92, 36,
+	55, 56, 0, 0, 32, 0, 0, 0, 0, 0,
+	0, 98, 99, 109, 110, 0, 0, 0, 40, 57,
+	58, 59, 0, 0, 75, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 117, 119, 112, 113, 114, 0,
+	106, 107, 108, 111, 0, 41, 61, 93, 0, 38,
+	0, 94, 42, 39, 96, 0, 241, 0, 0, 0,
+	0, 54, 0, 63, 65, 0, 0, 64, 0, 60,
+	0, 35, 0, 0, 0, 33, 0, 44, 0, 62,
+	36, 55, 56, 0, 0, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
+	57, 58, 59, 0, 0, 75, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 36, 55, 56, 0,
+	0, 32, 0, 0, 0, 0, 41, 61, 0, 0,
+	38, 0, 0, 42, 39, 40, 57, 58, 59, 0,
+	0, 75, 54, 0, 63, 65, 0, 0, 64, 0,
+	60, 0, 35, 0, 0, 222, 33, 0, 44, 0,
+	62, 0, 41, 61, 0, 0, 38, 0, 0, 42,
+	39, 0, 0, 189, 0, 0, 0, 0, 54, 0,
+	63, 65, 0, 0, 64, 0, 60, 0, 35, 0,
+	0, 0, 33, 0, 44, 0, 62, 36, 55, 56,
+	0, 0, 32, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 40, 57, 58, 59,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 36, 55, 56, 0, 0, 32, 0,
+	0, 0, 0, 41, 61, 0, 0, 38, 0, 0,
+	42, 39, 40, 57, 58, 59, 0, 0, 75, 54,
+	0, 63, 65, 0, 0, 64, 0, 60, 0, 35,
+	0, 0, 0, 33, 0, 44, 0, 62, 0, 41,
+	61, 0, 0, 38, 0, 0, 42, 39, 0, 0,
+	0, 0, 0, 0, 0, 54, 0, 63, 65, 0,
+	0, 64, 0, 407, 0, 35, 0, 0, 0, 33,
+	0, 44, 0, 62, 36, 55, 56, 0, 0, 32,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 39, 54, 55, 56, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 35, 52,
-	53, 0, 0, 31, 0, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 39, 54, 55,
-	56, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 42, 0, 34, 35, 52, 53, 32, 305, 31,
-	58, 0, 0, 0, 40, 57, 0, 0, 37, 0,
-	0, 41, 38, 39, 54, 55, 56, 0, 0, 51,
-	0, 59, 61, 0, 0, 60, 0, 42, 0, 34,
-	0, 0, 261, 32, 0, 0, 58, 0, 0, 0,
-	40, 57, 0, 0, 37, 0, 0, 41, 38, 0,
-	224, 0, 0, 0, 0, 51, 0, 59, 61, 0,
-	0, 60, 0, 42, 0, 34, 35, 52, 53, 32,
-	0, 31, 58, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 39, 54, 55, 56, 0,
+	0, 0, 0, 40, 57, 58, 59, 0, 0, 75,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 35, 52, 53, 0, 0, 31, 0, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 42, 0, 34, 0, 0,
-	205, 32, 0, 0, 58, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 0, 172, 0,
-	0, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 42, 0, 34, 35, 52, 53, 32, 0, 31,
-	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 39, 54, 55, 56, 0, 0, 0,
+	36, 55, 56, 0, 0, 32, 0, 0, 0, 0,
+	41, 61, 0, 0, 38, 0, 0, 42, 39, 40,
+	57, 58, 59, 0, 0, 75, 54, 0, 63, 65,
+	0, 0, 64, 0, 357, 0, 35, 0, 0, 0,
+	33, 0, 44, 0, 62, 0, 41, 61, 0, 0,
+	38, 0, 0, 42, 39, 0, 0, 0, 0, 0,
+	0, 0, 54, 0, 63, 65, 0, 0, 64, 0,
+	355, 0, 35, 0, 0, 0, 33, 0, 44, 0,
+	62, 36, 55, 56, 0, 0, 32, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	35, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	40, 57, 0, 0, 37, 0, 0, 41, 38, 39,
-	54, 55, 56, 0, 0, 51, 0, 59, 61, 0,
-	0, 60, 0, 42, 0, 34, 35, 52, 53, 32,
-	0, 31, 58, 0, 0, 0, 40, 57, 0, 0,
-	37, 0, 0, 41, 38, 39, 54, 55, 56, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 362,
-	0, 34, 35, 52, 53, 32, 0, 31, 58, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 316, 0, 34, 35, 52,
-	53, 32, 0, 31, 58, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 39, 54, 55,
-	56, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 314, 0, 34, 0, 0, 0, 32, 0, 0,
-	58, 0, 0, 0, 40, 57, 0, 0, 37, 0,
-	0, 41, 38, 0, 86, 106, 107, 111, 109, 51,
-	112, 59, 61, 0, 0, 60, 0, 258, 0, 34,
-	0, 0, 0, 32, 0, 0, 58, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 0,
+	40, 57, 58, 59, 0, 0, 75, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 36, 159, 56,
+	0, 0, 32, 0, 0, 0, 0, 41, 61, 0,
+	0, 38, 0, 0, 42, 39, 40, 57, 58, 59,
+	0, 0, 75, 54, 0, 63, 65, 0, 0, 64,
+	0, 290, 0, 35, 0, 0, 0, 33, 0, 44,
+	0, 62, 0, 41, 61, 0, 0, 38, 0, 0,
+	42, 39, 0, 0, 0, 0, 0, 0, 0, 54,
+	0, 63, 65, 0, 0, 64, 0, 60, 0, 35,
+	0, 0, 0, 33, 0, 44, 0, 62, 84, 55,
+	56, 0, 0, 32, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 40, 57, 58,
+	59, 0, 0, 75, 0, 0, 0, 0, 0, 0,
+	0, 0, 95, 115, 116, 120, 118, 0, 121, 0,
+	0, 0, 0, 0, 41, 61, 0, 0, 38, 0,
+	0, 42, 39, 0, 0, 0, 98, 99, 109, 110,
+	54, 0, 63, 65, 0, 0, 64, 0, 60, 0,
+	35, 0, 0, 0, 33, 0, 44, 0, 62, 117,
+	119, 112, 113, 114, 0, 106, 107, 108, 111, 0,
+	0, 0, 93, 0, 0, 0, 94, 0, 0, 96,
+	95, 115, 116, 120, 118, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	35, 150, 53, 84, 0, 31, 0, 85, 0, 87,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 39,
-	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 75, 52, 53, 0,
-	0, 31, 0, 0, 0, 0, 40, 57, 0, 0,
-	37, 0, 0, 41, 38, 39, 54, 55, 56, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 42,
-	0, 34, 0, 0, 0, 32, 0, 0, 58, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 86, 106, 107, 111, 109, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 42, 0, 34, 0, 0,
-	0, 32, 0, 0, 58, 89, 90, 100, 101, 0,
+	0, 0, 0, 0, 98, 99, 109, 110, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 108, 110, 103,
-	104, 105, 0, 97, 98, 99, 102, 0, 0, 0,
-	84, 0, 0, 0, 85, 0, 87,
+	0, 0, 0, 0, 0, 0, 0, 117, 119, 112,
+	113, 114, 0, 106, 107, 108, 111, 0, 0, 0,
+	93, 0, 0, 0, 94, 0, 0, 96,
 }
 
 var yyPact = [...]int{
-	-67, -1000, 631, -67, -1000, -71, -71, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3490, 3490, 306, 213, 3782, 93, 89,
-	283, -1000, -1000, 1226, -1000, -1000, 3490, 465, 3490, -1000,
-	-1000, 146, -59, 206, 3490, 40, -28, 76, 62, 59,
-	53, -7, -71, -1000, -1000, -1000, -1000, -1000, 302, 71,
-	-1000, 3746, -1000, -1000, -1000, -1000, -1000, 3490, 3490, 3490,
-	3490, 3490, -1000, -1000, -1000, -1000, -1000, 631, -71, -1000,
-	-8, 2942, 2942, 210, -67, 50, 3008, 3490, 3490, 200,
-	3490, 3490, 3490, 3490, 3490, 3418, 3490, 303, 3490, -1000,
-	-1000, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490,
-	3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490,
-	3490, 3490, 3490, 3490, 2876, -67, 49, 553, 3382, -34,
-	40, 2810, 302, 16, -25, 3490, -71, -10, -1000, 206,
-	206, -15, 206, 209, -30, 2744, 3490, 3310, 3490, 3490,
-	206, 179, -71, 206, 3490, 65, -1000, 3490, 3490, -71,
-	-1000, -41, 3074, -41, -41, -41, -41, -1000, -67, 197,
-	3490, 3490, 1160, 2678, 3490, -67, 2942, 2942, 2612, 3140,
-	163, 1094, 3490, 189, -1000, 3074, 2942, 2942, 2942, 2942,
-	2942, 2942, 189, 189, 189, 189, 189, 189, 120, 120,
-	120, 484, 484, 484, 484, 484, 484, 3825, 3678, -67,
-	195, -71, 3490, -71, -67, 3634, 2546, 3274, -71, 139,
-	302, -1000, -60, -71, 301, -42, -42, 206, -42, -71,
-	-25, -1000, 121, 1028, 3490, 2480, 2414, -50, -44, 300,
-	3490, -35, -64, 2348, 3490, -8, 2942, 3490, 193, 266,
-	110, 77, -1000, 3490, -1000, 2282, 192, 3490, 12, -1000,
-	-1000, 3238, 962, 191, -1000, 2216, 297, 186, -67, 2150,
-	3598, 3562, 2084, 247, 208, 4, 45, -71, -31, -71,
-	3490, -1000, -38, 295, 1, -1000, -1000, 3166, 896, -1000,
-	-1000, -1000, -1000, 3490, -3, -64, 206, 185, -71, 3490,
-	-8, 2942, -28, -1000, 180, 0, -1000, -1, -1000, 2018,
-	-67, -1000, 3074, -1000, 830, -1000, -1000, 3490, -1000, -67,
-	-1000, -1000, 183, -67, -67, 1952, -67, 1886, 3526, -36,
-	-1000, -1000, 134, 3490, -67, 207, 204, -9, -71, -1000,
-	-60, 206, -63, 206, -1000, 764, -1000, -1000, 3490, 698,
-	3490, 182, -51, -1000, 3490, 2942, 203, -67, -1000, -1000,
-	-1000, 177, -1000, 3490, 1820, 175, -1000, 168, 159, -67,
-	152, -67, -67, 1754, 150, -1000, -1000, -67, 1688, 13,
-	140, -67, -67, 202, 137, -42, 135, -71, -42, -1000,
-	3490, 1622, -1000, 3490, 1556, -1000, -71, 1490, -67, 128,
-	-1000, 1424, -1000, -1000, -1000, -1000, 122, -1000, 107, 106,
-	-67, -1000, -1000, -67, -67, -1000, 104, 103, -67, -1000,
-	-1000, 294, 1358, -1000, 1292, -1000, 3490, 3490, 102, 263,
-	-1000, -1000, -1000, -1000, 100, -1000, -1000, -1000, -1000, 98,
-	206, -1000, -1000, -64, 2942, 257, 199, -1000, -1000, -42,
-	96, 164, -67, -1000, -67, 78, 46, -1000, -1000,
+	-74, -1000, -36, 588, -74, -1000, -1000, 588, -74, -74,
+	-1000, -1000, -1000, -1000, 3883, 3883, 355, 156, 4234, 151,
+	148, 327, -1000, -1000, 1337, -1000, -1000, 3883, 3382, 3883,
+	-1000, -1000, 183, -43, 76, 3883, 134, -40, 146, 141,
+	140, 137, 3, -1000, 255, -1000, -1000, -1000, -1000, -1000,
+	350, 350, 121, -1000, 4153, -1000, -1000, -1000, -1000, -1000,
+	-74, 3883, 3883, 3883, 3883, 3883, -1000, -1000, -1000, -1000,
+	-1000, -1000, -74, 11, 3241, 182, 3241, 253, 282, -1000,
+	-74, -1, -23, 3883, 136, 3309, 3883, 3883, 272, 3883,
+	3883, 3883, 3883, 3883, 3802, 3883, 353, 3883, -1000, -1000,
+	3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883,
+	3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883, 3883,
+	3883, 3883, 3883, 3173, -74, 116, 725, 3766, -3, 134,
+	3105, 350, 132, -24, 3883, -2, -1000, 76, 76, -6,
+	76, 252, -46, 3037, 3883, 3685, 3883, 3883, 76, 229,
+	-74, 76, -1000, 77, 170, 66, 3883, 3883, -74, -1000,
+	-29, 3883, 3451, -29, -29, -29, -29, -74, 308, -1000,
+	131, 242, 3883, 352, 3883, 351, 2969, 3883, 3883, 1269,
+	2901, 3883, -74, 3241, 3241, 2833, 3661, 218, 1201, 3883,
+	163, -1000, 3451, 3241, 3241, 3241, 3241, 3241, 3241, 163,
+	163, 163, 163, 163, 163, 126, 126, 126, 494, 494,
+	494, 494, 494, 494, 4324, 4256, -74, 239, 588, 3883,
+	-74, -74, 4117, 2765, 3592, -74, 213, 350, -1000, -44,
+	-74, 349, -61, -61, 76, -61, -74, -24, -1000, 210,
+	1133, 3883, 2697, 2629, -10, -33, 348, 3883, -13, 3883,
+	-74, -1000, 76, 3883, 11, 3241, 3883, -58, 2561, 238,
+	251, -1000, -1000, 251, 347, -1000, 203, -1000, 175, -1000,
+	-1000, 157, 123, -1000, 3883, -1000, 2493, 233, 3883, 108,
+	-1000, -1000, 3556, 1065, 232, -1000, 657, 2425, 344, 230,
+	-74, 2357, 4036, 4000, 2289, 290, 270, 107, 86, -74,
+	-56, -74, 3883, -1000, -22, 343, 99, -1000, -1000, 3475,
+	997, -1000, -1000, -1000, -1000, 3883, 19, -58, 76, 11,
+	340, -61, 11, 3241, -40, -74, 228, 3883, -1000, -1000,
+	-1000, 98, 97, -1000, 62, -1000, 57, -1000, 23, -1000,
+	2221, -74, -1000, 3451, -1000, 929, -1000, -1000, 3883, -1000,
+	-74, -1000, -1000, 227, -74, -74, 2153, -74, 2085, 3919,
+	-14, -1000, -1000, 273, 3883, 249, 81, 270, 270, 22,
+	-74, -1000, -44, 76, -52, 76, -1000, 861, -1000, -1000,
+	3883, 793, 3883, 226, -26, -1000, 3883, -1000, 3241, 237,
+	76, -1000, -1000, -1000, -1000, -1000, 222, -1000, 3883, 2017,
+	214, -1000, 209, 198, -74, 197, -74, -74, 1949, 194,
+	-1000, -1000, -74, 1881, 92, -74, -61, 76, 248, 246,
+	270, 192, -61, 191, -74, -61, -1000, 3883, 1813, -1000,
+	3883, 1745, -1000, -74, 170, 1677, -1000, 3883, -25, -1000,
+	1609, -1000, -1000, -1000, -1000, 186, -1000, 185, 181, -74,
+	-1000, -1000, -74, -74, 179, 1, -61, -74, -74, 244,
+	-1000, -1000, 339, 1541, -1000, 1473, -1000, 3883, -1000, 3883,
+	1405, 225, -1000, -1000, -1000, -1000, 172, -1000, -1000, -1000,
+	-1000, 76, 169, 166, -74, 76, -1000, -1000, -58, 3241,
+	-1000, -1000, 3883, -1000, -61, -1000, -1000, 153, -61, 117,
+	1405, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 41, 353, 279, 303, 352, 350, 349, 348, 346,
-	344, 6, 5, 56, 0, 8, 25, 343, 2, 340,
-	338, 7, 337, 4, 335, 333, 330, 328, 323, 321,
-	320, 316, 314, 313, 311, 159, 1, 179, 48,
+	0, 46, 416, 342, 333, 414, 412, 411, 24, 1,
+	410, 409, 408, 407, 10, 7, 304, 0, 405, 17,
+	5, 21, 403, 39, 400, 2, 396, 395, 14, 394,
+	392, 386, 3, 300, 382, 380, 377, 375, 373, 372,
+	370, 367, 364, 215, 363, 12, 361, 6, 13,
 }
 
 var yyR1 = [...]int{
-	0, 1, 1, 2, 2, 3, 3, 3, 3, 3,
+	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 4, 4, 5,
-	6, 6, 6, 6, 7, 7, 7, 8, 8, 8,
-	8, 8, 8, 8, 8, 8, 8, 8, 9, 10,
-	10, 10, 10, 10, 11, 11, 12, 13, 13, 13,
-	13, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 14, 14, 15, 15, 15, 16, 16,
-	16, 16, 16, 16, 16, 17, 17, 18, 18, 19,
-	19, 20, 21, 22, 22, 22, 22, 22, 22, 23,
-	23, 23, 24, 24, 24, 24, 24, 24, 24, 24,
-	24, 24, 25, 25, 26, 26, 26, 26, 26, 27,
-	27, 27, 27, 28, 28, 28, 28, 28, 28, 28,
-	28, 32, 32, 32, 32, 32, 32, 31, 31, 31,
-	30, 30, 30, 30, 30, 30, 29, 29, 33, 33,
-	34, 34, 34, 35, 35, 37, 37, 38, 36, 36,
-	36, 36,
+	3, 3, 3, 3, 4, 4, 5, 5, 6, 6,
+	6, 6, 7, 7, 8, 8, 8, 8, 8, 8,
+	9, 10, 10, 10, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 12, 13, 13, 13, 13,
+	13, 14, 14, 15, 16, 16, 16, 16, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 18, 18, 18, 19, 19, 46,
+	19, 20, 20, 21, 21, 21, 22, 22, 23, 23,
+	23, 23, 23, 23, 23, 24, 24, 25, 25, 26,
+	26, 27, 29, 29, 29, 29, 30, 30, 30, 28,
+	31, 31, 31, 31, 31, 31, 32, 32, 32, 33,
+	34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
+	35, 35, 36, 36, 36, 36, 36, 37, 37, 37,
+	37, 38, 38, 38, 38, 38, 38, 38, 38, 42,
+	42, 42, 42, 42, 42, 41, 41, 41, 40, 40,
+	40, 40, 40, 40, 39, 39, 44, 44, 44, 43,
+	43, 47, 47, 48, 45, 45, 45, 45,
 }
 
 var yyR2 = [...]int{
-	0, 1, 2, 2, 3, 0, 1, 1, 1, 2,
-	2, 5, 13, 12, 9, 8, 6, 5, 6, 5,
-	4, 6, 4, 1, 1, 1, 1, 1, 1, 4,
-	3, 3, 3, 3, 5, 7, 5, 4, 7, 5,
-	6, 7, 7, 8, 7, 8, 8, 9, 7, 0,
-	1, 1, 2, 2, 4, 4, 3, 0, 1, 4,
-	4, 1, 1, 5, 3, 7, 8, 8, 9, 2,
-	5, 7, 3, 5, 4, 5, 4, 4, 4, 4,
-	4, 4, 4, 6, 8, 7, 3, 6, 10, 5,
-	1, 1, 1, 1, 1, 0, 1, 4, 1, 3,
+	0, 1, 2, 3, 0, 1, 1, 1, 2, 2,
+	5, 3, 5, 6, 5, 6, 5, 4, 6, 4,
+	1, 1, 1, 1, 1, 1, 4, 4, 3, 3,
+	3, 3, 1, 2, 2, 3, 5, 7, 7, 9,
+	3, 5, 7, 5, 4, 7, 5, 6, 7, 7,
+	8, 7, 8, 8, 9, 7, 0, 1, 1, 2,
+	2, 4, 4, 3, 0, 1, 4, 4, 1, 1,
+	5, 3, 8, 9, 9, 10, 2, 5, 7, 3,
+	2, 5, 4, 5, 4, 4, 4, 4, 4, 4,
+	4, 6, 8, 7, 3, 6, 10, 1, 2, 1,
+	1, 1, 1, 1, 0, 1, 4, 0, 2, 0,
+	6, 0, 2, 0, 2, 4, 1, 3, 1, 3,
 	2, 2, 5, 2, 6, 2, 5, 2, 3, 1,
-	1, 3, 1, 2, 1, 1, 1, 1, 1, 0,
-	3, 6, 6, 5, 5, 7, 8, 6, 5, 5,
-	7, 8, 3, 2, 2, 2, 2, 2, 2, 1,
-	1, 1, 1, 2, 2, 3, 3, 3, 3, 3,
+	1, 3, 5, 4, 5, 4, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 0, 3, 6, 5,
+	6, 5, 5, 7, 8, 6, 5, 5, 7, 8,
+	3, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 2, 2, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 0, 1,
-	2, 1, 1, 0, 1, 1, 2, 1, 0, 2,
-	1, 1,
+	3, 3, 3, 3, 3, 3, 2, 1, 1, 0,
+	1, 1, 2, 1, 0, 2, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -1, -33, -2, -34, 78, -37, -38, 83, -3,
-	-4, 38, 39, 10, 12, 28, 29, 47, 55, 56,
-	-7, -8, -9, -14, -5, -6, 13, 15, 44, -19,
-	-22, 9, 79, -18, 75, 4, -21, 54, 58, 23,
-	50, 57, 73, -24, -25, -26, -27, -28, 11, -13,
-	-20, 65, 5, 6, 24, 25, 26, 51, 82, 67,
-	71, 68, -32, -31, -30, -29, -33, -34, -37, -38,
-	-13, -14, -14, 4, 73, 4, -14, 75, 75, 14,
-	59, 52, 61, 27, 75, 79, 16, 81, 51, 40,
-	41, 32, 33, 37, 34, 35, 36, 68, 69, 70,
-	42, 43, 71, 64, 65, 66, 17, 18, 62, 20,
-	63, 19, 22, 21, -14, 73, -15, -14, 78, -4,
-	4, -14, 75, 4, 80, -35, -37, -16, 4, 68,
-	-18, 57, 48, 49, 79, -14, 75, 79, 75, 75,
-	75, 75, 73, 79, -35, -15, 4, 59, 52, 77,
-	5, -14, -14, -14, -14, -14, -14, -3, 73, -1,
-	75, 75, -14, -14, 13, 73, -14, -14, -14, -14,
-	-13, -14, 60, -14, 4, -14, -14, -14, -14, -14,
-	-14, -14, -14, -14, -14, -14, -14, -14, -14, -14,
-	-14, -14, -14, -14, -14, -14, -14, -14, -14, 73,
-	-1, -37, 16, 77, 73, 78, -14, 78, 73, -15,
-	75, -18, -13, 73, 81, -16, -16, 79, -16, 73,
-	80, 76, -13, -14, 60, -14, -14, -16, -16, 53,
-	-35, -16, -23, -14, 59, -13, -14, -35, -1, 74,
-	-13, -13, 76, 77, 76, -14, -1, 60, 8, 76,
-	80, 60, -14, -1, 74, -14, -35, -1, 73, -14,
-	78, 78, -14, -35, 76, 8, -15, 77, -36, -37,
-	-35, 4, -16, -35, 8, 76, 80, 60, -14, 76,
-	76, 76, 76, 77, 4, -23, 80, -36, 77, 60,
-	-13, -14, -21, 74, 30, 8, 76, 8, 76, -14,
-	73, 74, -14, 76, -14, 80, 80, 60, 74, 73,
-	4, 74, -1, 73, 73, -14, 73, -14, 78, -10,
-	-12, -11, 46, 45, 73, 76, 76, 8, -37, 80,
-	-13, 80, -17, 4, 76, -14, 80, 80, 60, -14,
-	77, -36, -16, 74, -35, -14, 4, 73, 76, 76,
-	76, -1, 80, 60, -14, -1, 74, -1, -1, 73,
-	-1, 73, 73, -14, -35, -11, -12, 60, -14, -13,
-	-1, 73, 73, 76, -36, -16, -35, 77, -16, 80,
-	60, -14, 76, 77, -14, 74, 73, -14, 73, -1,
-	74, -14, 80, 74, 74, 74, -1, 74, -1, -1,
-	73, 74, -1, 60, 60, 74, -1, -1, 73, 74,
-	74, -35, -14, 80, -14, 76, -35, 60, -1, 74,
-	80, 74, 74, 74, -1, -1, -1, 74, 74, -1,
-	4, 80, 76, -23, -14, 74, 31, 74, 74, -16,
-	-36, 31, 73, 74, 73, -1, -1, 74, 74,
+	-1000, -1, -2, -43, -47, -48, 85, -44, 79, -47,
+	-3, -4, 38, 39, 10, 12, 28, 29, 47, 55,
+	56, -10, -11, -12, -17, -5, -6, 13, 15, 44,
+	-26, -31, 9, 80, -25, 76, 4, -28, 54, 58,
+	23, 50, 57, -33, 82, -34, -35, -36, -37, -38,
+	11, 59, -16, -27, 66, 5, 6, 24, 25, 26,
+	74, 51, 84, 68, 72, 69, -42, -41, -40, -39,
+	-48, -3, -47, -16, -17, 29, -17, 4, -9, -29,
+	74, 4, -30, 76, 4, -17, 76, 76, 14, 60,
+	52, 62, 27, 76, 80, 16, 83, 51, 40, 41,
+	32, 33, 37, 34, 35, 36, 69, 70, 71, 42,
+	43, 72, 65, 66, 67, 17, 18, 63, 20, 64,
+	19, 22, 21, -17, 74, -18, -17, 79, -4, 4,
+	-17, 76, 4, 81, -43, -23, 4, 69, -25, 57,
+	48, 49, 80, -17, 76, 80, 76, 76, 76, 76,
+	74, 80, -33, -19, 4, -19, 60, 52, 78, 5,
+	-17, -43, -17, -17, -17, -17, -17, 74, -7, -8,
+	30, -1, 76, 83, 76, 83, -17, 76, 76, -17,
+	-17, 13, 74, -17, -17, -17, -17, -16, -17, 61,
+	-17, 4, -17, -17, -17, -17, -17, -17, -17, -17,
+	-17, -17, -17, -17, -17, -17, -17, -17, -17, -17,
+	-17, -17, -17, -17, -17, -17, 74, -1, -43, 16,
+	78, 74, 79, -17, 79, 74, -19, 76, -25, -16,
+	74, 83, -23, -23, 80, -23, 74, 81, 77, -16,
+	-17, 61, -17, -17, -23, -23, 53, -43, -23, 60,
+	78, -20, 61, 60, -16, -17, -43, -32, -17, -1,
+	31, -8, -9, 4, 76, 75, -16, 4, -16, 4,
+	77, -16, -16, 77, 78, 77, -17, -1, 61, 8,
+	77, 81, 61, -17, -1, 75, -17, -17, -43, -1,
+	74, -17, 79, 79, -17, -43, 77, 8, -19, 78,
+	-45, -47, -43, 4, -23, -43, 8, 77, 81, 61,
+	-17, 77, 77, 77, 77, 78, 4, -32, 81, -16,
+	-43, -23, -16, -17, -28, 78, -45, 61, 75, -9,
+	-9, 4, 8, 77, 8, 77, 8, 77, 8, 77,
+	-17, 74, 75, -17, 77, -17, 81, 81, 61, 75,
+	74, 4, 75, -1, 74, 74, -17, 74, -17, 79,
+	-13, -15, -14, 46, 45, -21, 61, 77, 77, 8,
+	-47, 81, -16, 81, -24, 4, 77, -17, 81, 81,
+	61, -17, 78, -45, -23, 4, -43, 75, -17, 77,
+	61, 77, 77, 77, 77, 77, -1, 81, 61, -17,
+	-1, 75, -1, -1, 74, -1, 74, 74, -17, -43,
+	-14, -15, 61, -17, -16, 74, -23, 76, -21, -21,
+	77, -45, -23, -43, 78, -23, 81, 61, -17, 77,
+	78, -17, 75, 74, -46, -17, -9, 13, -23, 75,
+	-17, 81, 75, 75, 75, -1, 75, -1, -1, 74,
+	75, -1, 61, 61, -1, -22, -23, 74, 74, -21,
+	75, 75, -43, -17, 81, -17, 77, -43, -20, 61,
+	-17, 77, 81, 75, 75, 75, -1, -1, -1, 75,
+	77, 78, -1, -1, 74, 4, 81, 77, -32, -17,
+	-9, -9, 13, 75, -23, 75, 75, -1, -23, -45,
+	-17, 75, 75, -9,
 }
 
 var yyDef = [...]int{
-	168, -2, -2, 168, 169, 172, 171, 175, 177, 3,
-	6, 7, 8, 57, 0, 0, 0, 0, 0, 0,
-	23, 24, 25, -2, 27, 28, 0, -2, 0, 61,
-	62, 0, 173, 0, 0, 112, 110, 0, 0, 0,
-	0, 0, 173, 90, 91, 92, 93, 94, 95, 0,
-	109, 0, 114, 115, 116, 117, 118, 0, 0, 0,
-	0, 0, 139, 140, 141, 142, 2, -2, 170, 176,
-	9, 58, 10, 0, 168, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 57, 0, 0, 0, 0, 143,
-	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	199, -2, 1, -2, 200, 201, 203, -2, 198, 197,
+	2, 5, 6, 7, 64, 0, 0, 0, 0, 0,
+	0, 20, 21, 22, -2, 24, 25, 0, -2, 0,
+	68, 69, 0, 199, 0, 0, 139, 130, 0, 0,
+	0, 0, 0, 97, 0, 99, 100, 101, 102, 103,
+	107, 107, 0, 129, 0, 141, 142, 143, 144, 145,
+	199, 0, 0, 0, 0, 0, 167, 168, 169, 170,
+	202, 3, 196, 8, 65, 0, 9, 0, 0, 80,
+	199, 0, 0, 0, 139, 0, 0, 0, 0, 0,
+	0, 0, 0, 64, 0, 0, 0, 0, 171, 172,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 168, 0, 58, 0, 0,
-	-2, 0, 95, 0, -2, 57, 174, 0, 98, 0,
-	0, 0, 0, 0, 0, 0, 57, 0, 0, 0,
-	0, 0, 173, 0, 119, 0, 96, 57, 0, 173,
-	113, 134, 133, 135, 136, 137, 138, 4, 168, 0,
-	57, 57, 0, 0, 0, 168, 30, 32, 0, 64,
-	0, 0, 0, 86, 111, 132, 145, 146, 147, 148,
-	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	0, 171, 0, 173, 168, 0, 0, 0, 173, 0,
-	95, 108, 178, 173, 0, 100, 101, 0, 103, 173,
-	107, 72, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 178, 0, 57, 31, 33, 0, 0, 0,
-	0, 0, 20, 0, 22, 0, 0, 0, 0, 76,
-	78, 0, 0, 0, 37, 0, 0, 0, 168, 0,
-	0, 0, 0, 49, 0, 0, 0, -2, 0, 180,
-	57, 99, 0, 0, 0, 74, 77, 0, 0, 79,
-	80, 81, 82, 0, 0, 178, 0, 0, -2, 0,
-	29, 59, -2, 11, 0, 0, -2, 0, -2, 0,
-	168, 36, 63, 75, 0, 128, 129, 0, 34, 168,
-	97, 39, 0, 168, 168, 0, 168, 0, 0, 173,
-	50, 51, 0, 57, 168, 0, 0, 0, -2, 70,
-	178, 0, 173, 0, 73, 0, 123, 124, 0, 0,
-	0, 0, 0, 89, 0, 120, 0, 168, -2, -2,
-	21, 0, 127, 0, 0, 0, 40, 0, 0, 168,
-	0, 168, 168, 0, 0, 52, 53, 168, 58, 0,
-	0, 168, 168, 0, 0, 102, 0, 173, 105, 122,
-	0, 0, 83, 0, 0, 87, 173, 0, 168, 0,
-	35, 0, 130, 38, 41, 42, 0, 44, 0, 0,
-	168, 48, 56, 168, 168, 65, 0, 0, 168, 71,
-	104, 0, 0, 125, 0, 85, 119, 0, 0, 15,
-	131, 43, 45, 46, 0, 54, 55, 66, 67, 0,
-	0, 126, 84, 178, 121, 14, 0, 47, 68, 106,
-	0, 0, 168, 88, 168, 0, 0, 13, 12,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 199, 0, 65, 0, 0, -2,
+	0, 107, 0, -2, 64, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 64, 0, 0, 0, 0, 0,
+	199, 0, 98, 0, 111, 0, 64, 0, 199, 140,
+	162, 146, 161, 163, 164, 165, 166, 199, 11, 32,
+	0, 0, 64, 0, 64, 0, 0, 64, 64, 0,
+	0, 0, 199, 28, 30, 0, 71, 0, 0, 0,
+	94, 131, 160, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 199, 0, -2, 0,
+	199, 199, 0, 0, 0, 199, 0, 107, 128, 204,
+	199, 0, 120, 121, 0, 123, 199, 127, 79, 0,
+	0, 0, 0, 0, 0, 0, 0, 146, 0, 64,
+	199, 108, 0, 64, 29, 31, 0, 204, 0, 0,
+	0, 33, 34, 0, 0, 40, 0, 136, 0, 137,
+	138, 0, 0, 17, 0, 19, 0, 0, 0, 0,
+	84, 86, 0, 0, 0, 44, -2, 0, 0, 0,
+	199, 0, 0, 0, 0, 56, 113, 0, 0, -2,
+	0, 206, 64, 119, 0, 0, 0, 82, 85, 0,
+	0, 87, 88, 89, 90, 0, 0, 204, 0, 26,
+	0, 112, 27, 66, -2, -2, 0, 0, 10, 12,
+	35, 0, 0, 133, 0, 135, 0, -2, 0, -2,
+	0, 199, 43, 70, 83, 0, 156, 157, 0, 41,
+	199, 106, 46, 0, 199, 199, 0, 199, 0, 0,
+	199, 57, 58, 0, 64, 0, 0, 113, 113, 0,
+	-2, 77, 204, 0, 199, 0, 81, 0, 151, 152,
+	0, 0, 0, 0, 0, 109, 0, 149, 147, 0,
+	0, 132, 134, -2, -2, 18, 0, 155, 0, 0,
+	0, 47, 0, 0, 199, 0, 199, 199, 0, 0,
+	59, 60, 199, 65, 0, 199, 114, 0, 0, 0,
+	113, 0, 122, 0, 199, 125, 150, 0, 0, 91,
+	0, 0, 95, 199, 111, 0, 36, 0, 0, 42,
+	0, 158, 45, 48, 49, 0, 51, 0, 0, 199,
+	55, 63, 199, 199, 0, 0, 116, 199, 199, 0,
+	78, 124, 0, 0, 153, 0, 93, 146, 110, 0,
+	0, 0, 159, 50, 52, 53, 0, 61, 62, 72,
+	115, 0, 0, 0, 199, 0, 154, 92, 204, 148,
+	37, 38, 0, 54, 117, 73, 74, 0, 126, 0,
+	0, 75, 96, 39,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	83, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	85, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 70, 71, 3,
-	75, 76, 68, 64, 77, 65, 81, 69, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 60, 78,
-	62, 59, 63, 61, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 84, 3, 3, 3, 71, 72, 3,
+	76, 77, 69, 65, 78, 66, 83, 70, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 61, 79,
+	63, 60, 64, 62, 82, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 79, 3, 80, 67, 3, 3, 3, 3, 3,
+	3, 80, 3, 81, 68, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 73, 66, 74,
+	3, 3, 3, 74, 67, 75,
 }
 
 var yyTok2 = [...]int{
@@ -910,7 +1015,7 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 72,
+	52, 53, 54, 55, 56, 57, 58, 59, 73,
 }
 
 var yyTok3 = [...]int{
@@ -1256,20 +1361,15 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:108
-		{
-			yyVAL.compstmt = nil
-		}
-	case 2:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:112
+//line parser.go.y:140
 		{
 			yyVAL.compstmt = yyDollar[1].stmts
 		}
-	case 3:
+	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:118
+//line parser.go.y:146
 		{
+			yyVAL.stmts = nil
 			if yyDollar[2].stmt != nil {
 				yyVAL.stmts = &ast.StmtsStmt{Stmts: []ast.Stmt{yyDollar[2].stmt}}
 			}
@@ -1277,9 +1377,9 @@ yydefault:
 				l.stmt = yyVAL.stmts
 			}
 		}
-	case 4:
+	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:127
+//line parser.go.y:156
 		{
 			if yyDollar[3].stmt != nil {
 				if yyDollar[1].stmts == nil {
@@ -1293,184 +1393,177 @@ yydefault:
 				}
 			}
 		}
-	case 5:
+	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:143
+//line parser.go.y:172
 		{
 			yyVAL.stmt = nil
 		}
-	case 6:
+	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:147
+//line parser.go.y:176
 		{
 			yyVAL.stmt = yyDollar[1].stmt_var_or_lets
 		}
-	case 7:
+	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:151
+//line parser.go.y:180
 		{
 			yyVAL.stmt = &ast.BreakStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 8:
+	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:156
+//line parser.go.y:185
 		{
 			yyVAL.stmt = &ast.ContinueStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 9:
+	case 8:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:161
+//line parser.go.y:190
 		{
 			yyVAL.stmt = &ast.ReturnStmt{Exprs: yyDollar[2].exprs}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 10:
+	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:166
+//line parser.go.y:195
 		{
 			yyVAL.stmt = &ast.ThrowStmt{Expr: yyDollar[2].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 11:
+	case 10:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:171
+//line parser.go.y:200
 		{
 			yyVAL.stmt = &ast.ModuleStmt{Name: yyDollar[2].tok.Lit, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 12:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser.go.y:176
-		{
-			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt, Finally: yyDollar[12].compstmt}
-			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
-		}
-	case 13:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser.go.y:181
-		{
-			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt, Finally: yyDollar[11].compstmt}
-			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
-		}
-	case 14:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:186
+	case 11:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:205
 		{
-			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt}
+			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[2].compstmt, Catches: yyDollar[3].stmt_catches}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 15:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:191
+	case 12:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:210
 		{
-			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt}
+			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[2].compstmt, Catches: yyDollar[3].stmt_catches, Finally: yyDollar[5].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 16:
+	case 13:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:196
+//line parser.go.y:215
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 17:
+	case 14:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:201
+//line parser.go.y:220
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 18:
+	case 15:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:206
+//line parser.go.y:225
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 19:
+	case 16:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:211
+//line parser.go.y:230
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 20:
+	case 17:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:216
+//line parser.go.y:235
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 21:
+	case 18:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:221
+//line parser.go.y:240
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr, Key: yyDollar[5].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 22:
+	case 19:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:226
+//line parser.go.y:245
 		{
 			yyVAL.stmt = &ast.CloseStmt{Expr: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 23:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:231
+//line parser.go.y:250
 		{
 			yyVAL.stmt = yyDollar[1].stmt_if
 		}
-	case 24:
+	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:235
+//line parser.go.y:254
 		{
 			yyVAL.stmt = yyDollar[1].stmt_for
 		}
-	case 25:
+	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:239
+//line parser.go.y:258
 		{
 			yyVAL.stmt = yyDollar[1].stmt_switch
 		}
-	case 26:
+	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:243
+//line parser.go.y:262
 		{
 			yyVAL.stmt = &ast.ExprStmt{Expr: yyDollar[1].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 27:
+	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:250
+//line parser.go.y:269
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_var
 		}
-	case 28:
+	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:254
+//line parser.go.y:273
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_lets
 		}
-	case 29:
+	case 26:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:260
+//line parser.go.y:279
 		{
-			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_idents, Exprs: yyDollar[4].exprs}
+			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_typed_idents.names, Types: yyDollar[2].expr_typed_idents.annotations(), Exprs: yyDollar[4].exprs}
 			yyVAL.stmt_var.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 30:
+	case 27:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:284
+		{
+			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_typed_idents.names, Types: yyDollar[2].expr_typed_idents.annotations(), Exprs: yyDollar[4].exprs, Const: true}
+			yyVAL.stmt_var.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:267
+//line parser.go.y:291
 		{
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{yyDollar[3].expr}}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 31:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:272
+//line parser.go.y:296
 		{
 			if len(yyDollar[1].exprs) == 2 && len(yyDollar[3].exprs) == 1 {
 				if _, ok := yyDollar[3].exprs[0].(*ast.ItemExpr); ok {
@@ -1483,16 +1576,16 @@ yydefault:
 			}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].exprs[0].Position())
 		}
-	case 32:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:285
+//line parser.go.y:309
 		{
 			yyVAL.stmt_lets = &ast.ChanStmt{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 33:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:290
+//line parser.go.y:314
 		{
 			if len(yyDollar[1].exprs) == 2 {
 				chanStmt := &ast.ChanStmt{LHS: yyDollar[1].exprs[0].(ast.Expr), OkExpr: yyDollar[1].exprs[1].(ast.Expr), RHS: yyDollar[3].expr}
@@ -1504,23 +1597,83 @@ yydefault:
 				yyVAL.stmt_lets.SetPosition(yyDollar[2].tok.Position())
 			}
 		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:328
+		{
+			yyVAL.stmt_catches = []ast.Stmt{yyDollar[1].stmt_catch}
+		}
+	case 33:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:332
+		{
+			yyVAL.stmt_catches = append(yyDollar[1].stmt_catches, yyDollar[2].stmt_catch)
+		}
 	case 34:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:338
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Stmt: yyDollar[2].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:343
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Var: yyDollar[2].tok.Lit, Stmt: yyDollar[3].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 36:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:348
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, Stmt: yyDollar[5].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 37:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:353
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, Cond: yyDollar[6].expr, Stmt: yyDollar[7].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 38:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:358
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, TypeData: yyDollar[5].type_data, Stmt: yyDollar[7].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 39:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:363
+		{
+			yyVAL.stmt_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, TypeData: yyDollar[5].type_data, Cond: yyDollar[8].expr, Stmt: yyDollar[9].compstmt}
+			yyVAL.stmt_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 40:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:371
+		{
+			yyVAL.compstmt = yyDollar[2].compstmt
+		}
+	case 41:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:304
+//line parser.go.y:377
 		{
 			yyVAL.stmt_if = &ast.IfStmt{If: yyDollar[2].expr, Then: yyDollar[4].compstmt, Else: nil}
 			yyVAL.stmt_if.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 35:
+	case 42:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:309
+//line parser.go.y:382
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			ifStmt.ElseIf = append(ifStmt.ElseIf, &ast.IfStmt{If: yyDollar[4].expr, Then: yyDollar[6].compstmt})
 		}
-	case 36:
+	case 43:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:314
+//line parser.go.y:387
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			if ifStmt.Else != nil {
@@ -1528,16 +1681,16 @@ yydefault:
 			}
 			ifStmt.Else = yyDollar[4].compstmt
 		}
-	case 37:
+	case 44:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:324
+//line parser.go.y:397
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Stmt: yyDollar[3].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 38:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:329
+//line parser.go.y:402
 		{
 			if len(yyDollar[2].expr_idents) < 1 {
 				yylex.Error("missing identifier")
@@ -1548,107 +1701,107 @@ yydefault:
 				yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 			}
 		}
-	case 39:
+	case 46:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:340
+//line parser.go.y:413
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Expr: yyDollar[2].expr, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 40:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:345
+//line parser.go.y:418
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt: yyDollar[5].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 41:
+	case 48:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:350
+//line parser.go.y:423
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr3: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 42:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:355
+//line parser.go.y:428
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 43:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:360
+//line parser.go.y:433
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 44:
+	case 51:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:365
+//line parser.go.y:438
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 45:
+	case 52:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:370
+//line parser.go.y:443
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 46:
+	case 53:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:375
+//line parser.go.y:448
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 47:
+	case 54:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:380
+//line parser.go.y:453
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Expr3: yyDollar[6].expr, Stmt: yyDollar[8].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:387
+//line parser.go.y:460
 		{
 			switchStmt := yyDollar[5].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Expr = yyDollar[2].expr
 			yyVAL.stmt_switch = switchStmt
 			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:396
+//line parser.go.y:469
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{}
 		}
-	case 50:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:400
+//line parser.go.y:473
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Default: yyDollar[1].stmt_switch_default}
 		}
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:404
+//line parser.go.y:477
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_switch_case}}
 		}
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:408
+//line parser.go.y:481
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Cases = append(switchStmt.Cases, yyDollar[2].stmt_switch_case)
 			yyVAL.stmt_switch_cases = switchStmt
 		}
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:414
+//line parser.go.y:487
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			if switchStmt.Default != nil {
@@ -1656,205 +1809,211 @@ yydefault:
 			}
 			switchStmt.Default = yyDollar[2].stmt_switch_default
 		}
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:424
+//line parser.go.y:497
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: []ast.Expr{yyDollar[2].expr}, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:429
+//line parser.go.y:502
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: yyDollar[2].exprs, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:436
+//line parser.go.y:509
 		{
 			yyVAL.stmt_switch_default = yyDollar[3].compstmt
 		}
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:443
+//line parser.go.y:516
 		{
 			yyVAL.exprs = nil
 		}
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:447
+//line parser.go.y:520
 		{
 			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
 		}
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:451
+//line parser.go.y:524
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
 			}
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
 		}
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:458
+//line parser.go.y:531
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
 			}
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr_ident)
 		}
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:467
+//line parser.go.y:540
 		{
 			yyVAL.expr = yyDollar[1].expr_member_or_ident
 		}
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:471
+//line parser.go.y:544
 		{
 			yyVAL.expr = yyDollar[1].expr_literals
 		}
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:475
+//line parser.go.y:548
 		{
 			yyVAL.expr = &ast.TernaryOpExpr{Expr: yyDollar[1].expr, LHS: yyDollar[3].expr, RHS: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:480
+//line parser.go.y:553
 		{
 			yyVAL.expr = &ast.NilCoalescingOpExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 65:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:485
+	case 72:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.go.y:558
 		{
-			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[6].compstmt}
+			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_typed_idents.names, ParamTypes: yyDollar[3].expr_typed_idents.annotations(), ReturnTypes: yyDollar[5].type_datas, Stmt: yyDollar[7].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 66:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:490
+	case 73:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:563
 		{
-			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[7].compstmt, VarArg: true}
+			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_typed_idents.names, ParamTypes: yyDollar[3].expr_typed_idents.annotations(), ReturnTypes: yyDollar[6].type_datas, Stmt: yyDollar[8].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 67:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:495
+	case 74:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:568
 		{
-			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[7].compstmt}
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_typed_idents.names, ParamTypes: yyDollar[4].expr_typed_idents.annotations(), ReturnTypes: yyDollar[6].type_datas, Stmt: yyDollar[8].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 68:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:500
+	case 75:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.go.y:573
 		{
-			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[8].compstmt, VarArg: true}
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_typed_idents.names, ParamTypes: yyDollar[4].expr_typed_idents.annotations(), ReturnTypes: yyDollar[7].type_datas, Stmt: yyDollar[9].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:505
+//line parser.go.y:578
 		{
 			yyVAL.expr = &ast.ArrayExpr{}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:510
+//line parser.go.y:583
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[3].exprs}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:515
+//line parser.go.y:588
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[5].exprs, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:520
+//line parser.go.y:593
 		{
 			yyVAL.expr = &ast.ParenExpr{SubExpr: yyDollar[2].expr}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 73:
+	case 80:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:598
+		{
+			yyVAL.expr = yyDollar[2].expr_try_call
+		}
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:525
+//line parser.go.y:602
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 74:
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:530
+//line parser.go.y:607
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 75:
+	case 83:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:535
+//line parser.go.y:612
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 76:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:540
+//line parser.go.y:617
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 77:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:545
+//line parser.go.y:622
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr_ident, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr_ident.Position())
 		}
-	case 78:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:550
+//line parser.go.y:627
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 79:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:555
+//line parser.go.y:632
 		{
 			yyVAL.expr = &ast.LenExpr{Expr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 80:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:560
+//line parser.go.y:637
 		{
 			yyVAL.expr = &ast.ImportExpr{Name: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 81:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:565
+//line parser.go.y:642
 		{
 			if yyDollar[3].type_data.Kind == ast.TypeDefault {
 				yyDollar[3].type_data.Kind = ast.TypePtr
@@ -1864,108 +2023,185 @@ yydefault:
 			}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 82:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:575
+//line parser.go.y:652
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 83:
+	case 91:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:580
+//line parser.go.y:657
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 84:
+	case 92:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:585
+//line parser.go.y:662
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr, CapExpr: yyDollar[7].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 85:
+	case 93:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:590
+//line parser.go.y:667
 		{
 			yyVAL.expr = &ast.MakeTypeExpr{Name: yyDollar[4].tok.Lit, Type: yyDollar[6].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 86:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:595
+//line parser.go.y:672
 		{
 			yyVAL.expr = &ast.IncludeExpr{ItemExpr: yyDollar[1].expr, ListExpr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 87:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:600
+//line parser.go.y:677
 		{
 			yyDollar[4].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: &ast.TypeStruct{Name: "interface"}, SubType: &ast.TypeStruct{Name: "interface"}}
 			yyVAL.expr = yyDollar[4].expr_map
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 88:
+	case 96:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser.go.y:606
+//line parser.go.y:683
 		{
 			yyDollar[8].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 			yyVAL.expr = yyDollar[8].expr_map
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 89:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:612
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:689
 		{
-			yyVAL.expr = yyDollar[3].expr_map
-			yyVAL.expr.SetPosition(yyDollar[3].expr_map.Position())
+			yyVAL.expr = yyDollar[1].expr_map
+			yyVAL.expr.SetPosition(yyDollar[1].expr_map.Position())
 		}
-	case 90:
+	case 98:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:694
+		{
+			yyDollar[2].expr_map.Ordered = true
+			yyVAL.expr = yyDollar[2].expr_map
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:617
+//line parser.go.y:700
 		{
 			yyVAL.expr = yyDollar[1].expr_slice
 			yyVAL.expr.SetPosition(yyDollar[1].expr_slice.Position())
 		}
-	case 91:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:622
+//line parser.go.y:705
 		{
 			yyVAL.expr = yyDollar[1].expr_chan
 			yyVAL.expr.SetPosition(yyDollar[1].expr_chan.Position())
 		}
-	case 95:
+	case 104:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:631
+//line parser.go.y:714
 		{
 			yyVAL.expr_idents = []string{}
 		}
-	case 96:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:635
+//line parser.go.y:718
 		{
 			yyVAL.expr_idents = []string{yyDollar[1].tok.Lit}
 		}
-	case 97:
+	case 106:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:639
+//line parser.go.y:722
 		{
 			if len(yyDollar[1].expr_idents) == 0 {
 				yylex.Error("syntax error: unexpected ','")
 			}
 			yyVAL.expr_idents = append(yyDollar[1].expr_idents, yyDollar[4].tok.Lit)
 		}
-	case 98:
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:730
+		{
+			yyVAL.expr_typed_idents = typedIdents{names: []string{}}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:734
+		{
+			yyVAL.expr_typed_idents = typedIdents{names: []string{yyDollar[1].tok.Lit}, types: []*ast.TypeStruct{yyDollar[2].type_data}}
+		}
+	case 109:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:738
+		{
+			// checked before the type annotation so the error has the position of the identifier
+			if len(yyDollar[1].expr_typed_idents.names) == 0 {
+				yylex.Error("syntax error: unexpected ','")
+			}
+		}
+	case 110:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:745
+		{
+			yyVAL.expr_typed_idents = typedIdents{names: append(yyDollar[1].expr_typed_idents.names, yyDollar[4].tok.Lit), types: append(yyDollar[1].expr_typed_idents.types, yyDollar[6].type_data)}
+		}
+	case 111:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:750
+		{
+			yyVAL.type_data = nil
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:754
+		{
+			yyVAL.type_data = yyDollar[2].type_data
+		}
+	case 113:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:759
+		{
+			yyVAL.type_datas = nil
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:763
+		{
+			yyVAL.type_datas = []*ast.TypeStruct{yyDollar[2].type_data}
+		}
+	case 115:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:767
+		{
+			yyVAL.type_datas = yyDollar[3].type_datas
+		}
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:648
+//line parser.go.y:773
+		{
+			yyVAL.type_datas = []*ast.TypeStruct{yyDollar[1].type_data}
+		}
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:777
+		{
+			yyVAL.type_datas = append(yyDollar[1].type_datas, yyDollar[3].type_data)
+		}
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:783
 		{
 			yyVAL.type_data = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
 		}
-	case 99:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:652
+//line parser.go.y:787
 		{
 			if yyDollar[1].type_data.Kind != ast.TypeDefault {
 				yylex.Error("not type default")
@@ -1974,9 +2210,9 @@ yydefault:
 				yyDollar[1].type_data.Name = yyDollar[3].tok.Lit
 			}
 		}
-	case 100:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:661
+//line parser.go.y:796
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypePtr
@@ -1985,9 +2221,9 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypePtr, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 101:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:670
+//line parser.go.y:805
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeSlice
@@ -1997,15 +2233,15 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}
 			}
 		}
-	case 102:
+	case 122:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:680
+//line parser.go.y:815
 		{
 			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 		}
-	case 103:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:684
+//line parser.go.y:819
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeChan
@@ -2014,21 +2250,21 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeChan, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 104:
+	case 124:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:693
+//line parser.go.y:828
 		{
 			yyVAL.type_data = yyDollar[4].type_data_struct
 		}
-	case 105:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:699
+//line parser.go.y:834
 		{
 			yyVAL.type_data_struct = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{yyDollar[1].tok.Lit}, StructTypes: []*ast.TypeStruct{yyDollar[2].type_data}}
 		}
-	case 106:
+	case 126:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:703
+//line parser.go.y:838
 		{
 			if yyDollar[1].type_data_struct == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2036,47 +2272,98 @@ yydefault:
 			yyVAL.type_data_struct.StructNames = append(yyVAL.type_data_struct.StructNames, yyDollar[4].tok.Lit)
 			yyVAL.type_data_struct.StructTypes = append(yyVAL.type_data_struct.StructTypes, yyDollar[5].type_data)
 		}
-	case 107:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:713
+//line parser.go.y:848
 		{
 			yyVAL.slice_count = 1
 		}
-	case 108:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:717
+//line parser.go.y:852
 		{
 			yyVAL.slice_count = yyDollar[3].slice_count + 1
 		}
-	case 109:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:723
+//line parser.go.y:858
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_member
 		}
-	case 110:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:727
+//line parser.go.y:862
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_ident
 		}
-	case 111:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:733
+//line parser.go.y:868
 		{
 			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit}
 			yyVAL.expr_member.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 112:
+	case 132:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:875
+		{
+			yyVAL.expr_try_call = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true, Raise: true}
+			yyVAL.expr_try_call.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 133:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:880
+		{
+			yyVAL.expr_try_call = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, Raise: true}
+			yyVAL.expr_try_call.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 134:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:885
+		{
+			yyVAL.expr_try_call = &ast.AnonCallExpr{Expr: yyDollar[1].expr_try_callee, SubExprs: yyDollar[3].exprs, VarArg: true, Raise: true}
+			yyVAL.expr_try_call.SetPosition(yyDollar[1].expr_try_callee.Position())
+		}
+	case 135:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:890
+		{
+			yyVAL.expr_try_call = &ast.AnonCallExpr{Expr: yyDollar[1].expr_try_callee, SubExprs: yyDollar[3].exprs, Raise: true}
+			yyVAL.expr_try_call.SetPosition(yyDollar[1].expr_try_callee.Position())
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:897
+		{
+			identExpr := &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
+			identExpr.SetPosition(yyDollar[1].tok.Position())
+			yyVAL.expr_try_callee = &ast.MemberExpr{Expr: identExpr, Name: yyDollar[3].tok.Lit}
+			yyVAL.expr_try_callee.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:904
+		{
+			yyVAL.expr_try_callee = &ast.MemberExpr{Expr: yyDollar[1].expr_try_callee, Name: yyDollar[3].tok.Lit}
+			yyVAL.expr_try_callee.SetPosition(yyDollar[1].expr_try_callee.Position())
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:909
+		{
+			yyVAL.expr_try_callee = &ast.ParenExpr{SubExpr: yyDollar[2].expr}
+			yyVAL.expr_try_callee.SetPosition(yyDollar[2].expr.Position())
+		}
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:740
+//line parser.go.y:916
 		{
 			yyVAL.expr_ident = &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
 			yyVAL.expr_ident.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 113:
+	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:747
+//line parser.go.y:923
 		{
 			num, err := toNumber("-" + yyDollar[2].tok.Lit)
 			if err != nil {
@@ -2085,9 +2372,9 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 114:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:756
+//line parser.go.y:932
 		{
 			num, err := toNumber(yyDollar[1].tok.Lit)
 			if err != nil {
@@ -2096,49 +2383,49 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 115:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:765
+//line parser.go.y:941
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: stringToValue(yyDollar[1].tok.Lit)}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 116:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:770
+//line parser.go.y:946
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: trueValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 117:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:775
+//line parser.go.y:951
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: falseValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 118:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:780
+//line parser.go.y:956
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: nilValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 119:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:787
+//line parser.go.y:963
 		{
 			yyVAL.expr_map = &ast.MapExpr{}
 		}
-	case 120:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:791
+//line parser.go.y:967
 		{
 			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{yyDollar[1].expr}, Values: []ast.Expr{yyDollar[3].expr}}
 		}
-	case 121:
+	case 148:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:795
+//line parser.go.y:971
 		{
 			if yyDollar[1].expr_map.Keys == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2146,144 +2433,150 @@ yydefault:
 			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, yyDollar[4].expr)
 			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, yyDollar[6].expr)
 		}
-	case 122:
+	case 149:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:981
+		{
+			yyVAL.expr_map = yyDollar[3].expr_map
+		}
+	case 150:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:805
+//line parser.go.y:987
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 123:
+	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:809
+//line parser.go.y:991
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 124:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:813
+//line parser.go.y:995
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 125:
+	case 153:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:817
+//line parser.go.y:999
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 126:
+	case 154:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:821
+//line parser.go.y:1003
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 127:
+	case 155:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:825
+//line parser.go.y:1007
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 128:
+	case 156:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:829
+//line parser.go.y:1011
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 129:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:833
+//line parser.go.y:1015
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 130:
+	case 158:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:837
+//line parser.go.y:1019
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 131:
+	case 159:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:841
+//line parser.go.y:1023
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 132:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:847
+//line parser.go.y:1029
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 133:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:851
+//line parser.go.y:1033
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{RHS: yyDollar[2].expr}
 		}
-	case 134:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:857
+//line parser.go.y:1039
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "-", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 135:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:862
+//line parser.go.y:1044
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "!", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 136:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:867
+//line parser.go.y:1049
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "^", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 137:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:872
+//line parser.go.y:1054
 		{
 			yyVAL.expr = &ast.AddrExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 138:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:877
+//line parser.go.y:1059
 		{
 			yyVAL.expr = &ast.DerefExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 139:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:884
+//line parser.go.y:1066
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 140:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:889
+//line parser.go.y:1071
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 141:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:894
+//line parser.go.y:1076
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 142:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:899
+//line parser.go.y:1081
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 143:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:906
+//line parser.go.y:1088
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2291,9 +2584,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 144:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:914
+//line parser.go.y:1096
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2301,9 +2594,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 145:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:922
+//line parser.go.y:1104
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2311,9 +2604,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 146:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:930
+//line parser.go.y:1112
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2321,9 +2614,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 147:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:938
+//line parser.go.y:1120
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2331,9 +2624,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 148:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:946
+//line parser.go.y:1128
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2341,9 +2634,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 149:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:954
+//line parser.go.y:1136
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2351,9 +2644,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 150:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:962
+//line parser.go.y:1144
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2361,121 +2654,121 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 151:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:973
+//line parser.go.y:1155
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 152:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:978
+//line parser.go.y:1160
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 153:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:983
+//line parser.go.y:1165
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "%", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 154:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:988
+//line parser.go.y:1170
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "<<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 155:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:993
+//line parser.go.y:1175
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: ">>", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 156:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:998
+//line parser.go.y:1180
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 157:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1005
+//line parser.go.y:1187
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 158:
+	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1010
+//line parser.go.y:1192
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 159:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1015
+//line parser.go.y:1197
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 160:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1022
+//line parser.go.y:1204
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "==", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 161:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1027
+//line parser.go.y:1209
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "!=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 162:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1032
+//line parser.go.y:1214
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 163:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1037
+//line parser.go.y:1219
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 164:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1042
+//line parser.go.y:1224
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 165:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1047
+//line parser.go.y:1229
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 166:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1054
+//line parser.go.y:1236
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "&&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 167:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1059
+//line parser.go.y:1241
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "||", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
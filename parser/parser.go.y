@@ -5,6 +5,22 @@ import (
 	"github.com/mattn/anko/ast"
 )
 
+// typedIdents are identifiers with optional type annotations
+type typedIdents struct {
+	names []string
+	types []*ast.TypeStruct
+}
+
+// annotations returns the types, or nil when no identifier has a type
+func (idents typedIdents) annotations() []*ast.TypeStruct {
+	for _, t := range idents.types {
+		if t != nil {
+			return idents.types
+		}
+	}
+	return nil
+}
+
 %}
 
 %type<compstmt> compstmt
@@ -13,6 +29,9 @@ import (
 %type<stmt_var_or_lets> stmt_var_or_lets
 %type<stmt_var> stmt_var
 %type<stmt_lets> stmt_lets
+%type<stmt_catches> stmt_catches
+%type<stmt_catch> stmt_catch
+%type<compstmt> stmt_block
 %type<stmt_if> stmt_if
 %type<stmt_for> stmt_for
 %type<stmt_switch> stmt_switch
@@ -23,14 +42,21 @@ import (
 %type<exprs> exprs
 %type<expr> expr
 %type<expr_idents> expr_idents
+%type<expr_typed_idents> expr_typed_idents
+%type<type_data> opt_type_annotation
+%type<type_datas> func_return_types
+%type<type_datas> type_datas
 %type<type_data> type_data
 %type<type_data_struct> type_data_struct
 %type<slice_count> slice_count
 %type<expr_member_or_ident> expr_member_or_ident
 %type<expr_member> expr_member
 %type<expr_ident> expr_ident
+%type<expr_try_call> expr_try_call
+%type<expr_try_callee> expr_try_callee
 %type<expr_literals> expr_literals
 %type<expr_map> expr_map
+%type<expr_map> expr_map_literal
 %type<expr_slice> expr_slice
 %type<expr_chan> expr_chan
 %type<expr> expr_unary
@@ -51,6 +77,8 @@ import (
 	stmt_var_or_lets       ast.Stmt
 	stmt_var               ast.Stmt
 	stmt_lets              ast.Stmt
+	stmt_catches           []ast.Stmt
+	stmt_catch             ast.Stmt
 	stmt_if                ast.Stmt
 	stmt_for               ast.Stmt
 	stmt_switch            ast.Stmt
@@ -61,12 +89,16 @@ import (
 	exprs                  []ast.Expr
 	expr                   ast.Expr
 	expr_idents            []string
+	expr_typed_idents      typedIdents
+	type_datas             []*ast.TypeStruct
 	type_data              *ast.TypeStruct
 	type_data_struct       *ast.TypeStruct
 	slice_count            int
 	expr_member_or_ident   ast.Expr
 	expr_member            *ast.MemberExpr
 	expr_ident             *ast.IdentExpr
+	expr_try_call          ast.Expr
+	expr_try_callee        ast.Expr
 	expr_literals          ast.Expr
 	expr_map               *ast.MapExpr
 	expr_slice             ast.Expr
@@ -81,7 +113,7 @@ import (
 	op_multiply            ast.Operator
 }
 
-%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN STRUCT MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT
+%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN STRUCT MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT CONST
 
 /* lowest precedence */
 %left ,
@@ -104,18 +136,15 @@ import (
 %%
 
 compstmt :
-	opt_term
-	{
-		$$ = nil
-	}
-	| stmts opt_term
+	stmts
 	{
 		$$ = $1
 	}
 
 stmts :
-	opt_term stmt
+	opt_newlines stmt
 	{
+		$$ = nil
 		if $2 != nil {
 			$$ = &ast.StmtsStmt{Stmts: []ast.Stmt{$2}}
 		}
@@ -172,24 +201,14 @@ stmt :
 		$$ = &ast.ModuleStmt{Name: $2.Lit, Stmt: $4}
 		$$.SetPosition($1.Position())
 	}
-	| TRY '{' compstmt '}' CATCH IDENT '{' compstmt '}' FINALLY '{' compstmt '}'
+	| TRY stmt_block stmt_catches
 	{
-		$$ = &ast.TryStmt{Try: $3, Var: $6.Lit, Catch: $8, Finally: $12}
+		$$ = &ast.TryStmt{Try: $2, Catches: $3}
 		$$.SetPosition($1.Position())
 	}
-	| TRY '{' compstmt '}' CATCH '{' compstmt '}' FINALLY '{' compstmt '}'
+	| TRY stmt_block stmt_catches FINALLY stmt_block
 	{
-		$$ = &ast.TryStmt{Try: $3, Catch: $7, Finally: $11}
-		$$.SetPosition($1.Position())
-	}
-	| TRY '{' compstmt '}' CATCH IDENT '{' compstmt '}'
-	{
-		$$ = &ast.TryStmt{Try: $3, Var: $6.Lit, Catch: $8}
-		$$.SetPosition($1.Position())
-	}
-	| TRY '{' compstmt '}' CATCH '{' compstmt '}'
-	{
-		$$ = &ast.TryStmt{Try: $3, Catch: $7}
+		$$ = &ast.TryStmt{Try: $2, Catches: $3, Finally: $5}
 		$$.SetPosition($1.Position())
 	}
 	| GO IDENT '(' exprs VARARG ')'
@@ -256,9 +275,14 @@ stmt_var_or_lets :
 	}
 
 stmt_var :
-	VAR expr_idents '=' exprs
+	VAR expr_typed_idents '=' exprs
+	{
+		$$ = &ast.VarStmt{Names: $2.names, Types: $2.annotations(), Exprs: $4}
+		$$.SetPosition($1.Position())
+	}
+	| CONST expr_typed_idents '=' exprs
 	{
-		$$ = &ast.VarStmt{Names: $2, Exprs: $4}
+		$$ = &ast.VarStmt{Names: $2.names, Types: $2.annotations(), Exprs: $4, Const: true}
 		$$.SetPosition($1.Position())
 	}
 
@@ -299,6 +323,55 @@ stmt_lets :
 		}
 	}
 
+stmt_catches :
+	stmt_catch
+	{
+		$$ = []ast.Stmt{$1}
+	}
+	| stmt_catches stmt_catch
+	{
+		$$ = append($1, $2)
+	}
+
+stmt_catch :
+	CATCH stmt_block
+	{
+		$$ = &ast.CatchStmt{Stmt: $2}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH IDENT stmt_block
+	{
+		$$ = &ast.CatchStmt{Var: $2.Lit, Stmt: $3}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH '(' IDENT ')' stmt_block
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, Stmt: $5}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH '(' IDENT ')' IF expr stmt_block
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, Cond: $6, Stmt: $7}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH '(' IDENT ':' type_data ')' stmt_block
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, TypeData: $5, Stmt: $7}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH '(' IDENT ':' type_data ')' IF expr stmt_block
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, TypeData: $5, Cond: $8, Stmt: $9}
+		$$.SetPosition($1.Position())
+	}
+
+/* one block rule for try, catch and finally so their braces share parser states */
+stmt_block :
+	'{' compstmt '}'
+	{
+		$$ = $2
+	}
+
 stmt_if :
 	IF expr '{' compstmt '}'
 	{
@@ -481,24 +554,24 @@ expr :
 		$$ = &ast.NilCoalescingOpExpr{LHS: $1, RHS: $3}
 		$$.SetPosition($1.Position())
 	}
-	| FUNC '(' expr_idents ')' '{' compstmt '}'
+	| FUNC '(' expr_typed_idents ')' func_return_types '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Params: $3, Stmt: $6}
+		$$ = &ast.FuncExpr{Params: $3.names, ParamTypes: $3.annotations(), ReturnTypes: $5, Stmt: $7}
 		$$.SetPosition($1.Position())
 	}
-	| FUNC '(' expr_idents VARARG ')' '{' compstmt '}'
+	| FUNC '(' expr_typed_idents VARARG ')' func_return_types '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Params: $3, Stmt: $7, VarArg: true}
+		$$ = &ast.FuncExpr{Params: $3.names, ParamTypes: $3.annotations(), ReturnTypes: $6, Stmt: $8, VarArg: true}
 		$$.SetPosition($1.Position())
 	}
-	| FUNC IDENT '(' expr_idents ')' '{' compstmt '}'
+	| FUNC IDENT '(' expr_typed_idents ')' func_return_types '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $7}
+		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4.names, ParamTypes: $4.annotations(), ReturnTypes: $6, Stmt: $8}
 		$$.SetPosition($1.Position())
 	}
-	| FUNC IDENT '(' expr_idents VARARG ')' '{' compstmt '}'
+	| FUNC IDENT '(' expr_typed_idents VARARG ')' func_return_types '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $8, VarArg: true}
+		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4.names, ParamTypes: $4.annotations(), ReturnTypes: $7, Stmt: $9, VarArg: true}
 		$$.SetPosition($1.Position())
 	}
 	| '[' ']'
@@ -521,6 +594,10 @@ expr :
 		$$ = &ast.ParenExpr{SubExpr: $2}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
+	| TRY expr_try_call
+	{
+		$$ = $2
+	}
 	| IDENT '(' exprs VARARG ')'
 	{
 		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: $3, VarArg: true}
@@ -608,10 +685,16 @@ expr :
 		$$ = $8
 		$$.SetPosition($1.Position())
 	}
-	| '{' opt_newlines expr_map opt_comma_newlines '}'
+	| expr_map_literal
 	{
-		$$ = $3
-		$$.SetPosition($3.Position())
+		$$ = $1
+		$$.SetPosition($1.Position())
+	}
+	| '@' expr_map_literal
+	{
+		$2.Ordered = true
+		$$ = $2
+		$$.SetPosition($<tok>1.Position())
 	}
 	| expr_slice
 	{
@@ -643,6 +726,58 @@ expr_idents :
 		$$ = append($1, $4.Lit)
 	}
 
+expr_typed_idents :
+	{
+		$$ = typedIdents{names: []string{}}
+	}
+	| IDENT opt_type_annotation
+	{
+		$$ = typedIdents{names: []string{$1.Lit}, types: []*ast.TypeStruct{$2}}
+	}
+	| expr_typed_idents ',' opt_newlines IDENT
+	{
+		// checked before the type annotation so the error has the position of the identifier
+		if len($1.names) == 0 {
+			yylex.Error("syntax error: unexpected ','")
+		}
+	}
+	opt_type_annotation
+	{
+		$$ = typedIdents{names: append($1.names, $4.Lit), types: append($1.types, $6)}
+	}
+
+opt_type_annotation :
+	{
+		$$ = nil
+	}
+	| ':' type_data
+	{
+		$$ = $2
+	}
+
+func_return_types :
+	{
+		$$ = nil
+	}
+	| ':' type_data
+	{
+		$$ = []*ast.TypeStruct{$2}
+	}
+	| ':' '(' type_datas ')'
+	{
+		$$ = $3
+	}
+
+type_datas :
+	type_data
+	{
+		$$ = []*ast.TypeStruct{$1}
+	}
+	| type_datas ',' type_data
+	{
+		$$ = append($1, $3)
+	}
+
 type_data :
 	IDENT
 	{
@@ -735,6 +870,47 @@ expr_member :
 		$$.SetPosition($1.Position())
 	}
 
+expr_try_call :
+	IDENT '(' exprs VARARG ')'
+	{
+		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: $3, VarArg: true, Raise: true}
+		$$.SetPosition($1.Position())
+	}
+	| IDENT '(' exprs ')'
+	{
+		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: $3, Raise: true}
+		$$.SetPosition($1.Position())
+	}
+	| expr_try_callee '(' exprs VARARG ')'
+	{
+		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: $3, VarArg: true, Raise: true}
+		$$.SetPosition($1.Position())
+	}
+	| expr_try_callee '(' exprs ')'
+	{
+		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: $3, Raise: true}
+		$$.SetPosition($1.Position())
+	}
+
+expr_try_callee :
+	IDENT '.' IDENT
+	{
+		identExpr := &ast.IdentExpr{Lit: $1.Lit}
+		identExpr.SetPosition($1.Position())
+		$$ = &ast.MemberExpr{Expr: identExpr, Name: $3.Lit}
+		$$.SetPosition($1.Position())
+	}
+	| expr_try_callee '.' IDENT
+	{
+		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit}
+		$$.SetPosition($1.Position())
+	}
+	| '(' expr ')'
+	{
+		$$ = &ast.ParenExpr{SubExpr: $2}
+		$$.SetPosition($2.Position())
+	}
+
 expr_ident :
 	IDENT
 	{
@@ -800,6 +976,12 @@ expr_map :
 		$$.Values = append($$.Values, $6)
 	}
 
+expr_map_literal :
+	'{' opt_newlines expr_map opt_comma_newlines '}'
+	{
+		$$ = $3
+	}
+
 expr_slice :
 	expr_ident '[' expr ':' expr ']'
 	{
@@ -1062,10 +1244,6 @@ op_binary :
 	}
 
 
-opt_term :
-	/* nothing */
-	| term
-	
 term :
 	';' newlines
 	| newlines
@@ -0,0 +1,562 @@
+// +build !appengine
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+	"github.com/peterh/liner"
+)
+
+const historyFilename = ".anko_history"
+
+var (
+	reflectValueType = reflect.TypeOf(reflect.Value{})
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// runInteractive runs the read eval print loop.
+// A terminal gets line editing, history, and completion, other input is read line by line.
+func runInteractive() int {
+	parser.EnableErrorVerbose()
+
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 || !liner.TerminalSupported() {
+		scanner := bufio.NewScanner(os.Stdin)
+		return runREPL(func(prompt string) (string, error) {
+			fmt.Print(prompt)
+			if !scanner.Scan() {
+				if err := scanner.Err(); err != nil {
+					return "", err
+				}
+				return "", io.EOF
+			}
+			return scanner.Text(), nil
+		})
+	}
+
+	state := liner.NewLiner()
+	defer state.Close()
+	state.SetCtrlCAborts(true)
+	state.SetTabCompletionStyle(liner.TabPrints)
+	state.SetWordCompleter(completeWord)
+
+	history := historyFile()
+	if history != "" {
+		file, err := os.Open(history)
+		if err == nil {
+			state.ReadHistory(file)
+			file.Close()
+		}
+		defer func() {
+			file, err := os.Create(history)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "history error:", err)
+				return
+			}
+			state.WriteHistory(file)
+			file.Close()
+		}()
+	}
+
+	return runREPL(func(prompt string) (string, error) {
+		line, err := state.Prompt(prompt)
+		if err == nil && strings.TrimSpace(line) != "" {
+			state.AppendHistory(line)
+		}
+		return line, err
+	})
+}
+
+// historyFile returns the name of the history file in the home directory
+func historyFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, historyFilename)
+}
+
+// runREPL reads lines with readLine and runs them when they are complete statements
+func runREPL(readLine func(prompt string) (string, error)) int {
+	repl := vm.NewREPL(e, nil)
+
+	for {
+		line, err := readLine(repl.Prompt())
+		if err == liner.ErrPromptAborted {
+			repl.Reset()
+			continue
+		}
+		if err != nil {
+			if err != io.EOF {
+				fmt.Fprintln(os.Stderr, "ReadString error:", err)
+				return 12
+			}
+			break
+		}
+
+		if !repl.Incomplete() {
+			if line == "quit()" {
+				break
+			}
+			if strings.HasPrefix(line, ":") {
+				runMetaCommand(repl, line)
+				continue
+			}
+		}
+
+		v, complete, err := repl.Eval(context.Background(), line)
+		if err != nil {
+			printError(err)
+			continue
+		}
+		if complete {
+			fmt.Println(formatValue(v))
+		}
+	}
+
+	return 0
+}
+
+// printError prints an error with its position to stderr
+func printError(err error) {
+	if e, ok := err.(*vm.Error); ok {
+		fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
+	} else if e, ok := err.(*parser.Error); ok {
+		fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// runMetaCommand runs a REPL command that starts with :
+func runMetaCommand(repl *vm.REPL, command string) {
+	name := command
+	argument := ""
+	if i := strings.IndexAny(command, " \t"); i > 0 {
+		name = command[:i]
+		argument = strings.TrimSpace(command[i+1:])
+	}
+
+	switch name {
+	case ":help":
+		fmt.Print(`:env         print the values and types of the env
+:type expr   print the type of the value of expr
+:load file   run the script file in the env
+:reset       start over with a new env
+:ast expr    print the syntax tree of expr
+quit()       exit
+`)
+
+	case ":env":
+		fmt.Print(e.String())
+
+	case ":type":
+		v, err := vm.Execute(e, nil, argument)
+		if err != nil {
+			printError(err)
+			return
+		}
+		if v == nil {
+			fmt.Println("nil")
+			return
+		}
+		fmt.Println(reflect.TypeOf(v))
+
+	case ":load":
+		source, err := ioutil.ReadFile(argument)
+		if err != nil {
+			printError(err)
+			return
+		}
+		v, err := vm.Execute(e, nil, string(source))
+		if err != nil {
+			printError(err)
+			return
+		}
+		fmt.Println(formatValue(v))
+
+	case ":reset":
+		setupEnv()
+		repl.SetEnv(e)
+
+	case ":ast":
+		stmt, err := parser.ParseSrc(argument)
+		if err != nil {
+			printError(err)
+			return
+		}
+		var builder strings.Builder
+		writeAST(&builder, reflect.ValueOf(stmt), "")
+		fmt.Print(builder.String())
+
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %v, :help prints the commands\n", name)
+	}
+}
+
+// completeWord returns the completions of the word before pos
+func completeWord(line string, pos int) (string, []string, string) {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	head := line[:pos]
+	tail := line[pos:]
+
+	// package names in import("
+	if i := strings.LastIndex(head, `import("`); i >= 0 && !strings.Contains(head[i+8:], `"`) {
+		prefix := head[i+8:]
+		var completions []string
+		for _, name := range e.PackageNames() {
+			if strings.HasPrefix(name, prefix) {
+				completions = append(completions, name)
+			}
+		}
+		return head[:i+8], completions, tail
+	}
+
+	start := len(head)
+	for start > 0 && isWordByte(head[start-1]) {
+		start--
+	}
+	word := head[start:]
+
+	var path string
+	var candidates []string
+	if dot := strings.LastIndexByte(word, '.'); dot >= 0 {
+		path = word[:dot+1]
+		value, err := lookupPath(strings.Split(word[:dot], "."))
+		if err != nil {
+			return head, nil, tail
+		}
+		candidates = memberNames(value)
+		word = word[dot+1:]
+	} else {
+		candidates = append(e.Symbols(), parser.Keywords()...)
+		sort.Strings(candidates)
+	}
+
+	var completions []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			completions = append(completions, path+candidate)
+		}
+	}
+	return head[:start], completions, tail
+}
+
+// isWordByte returns true if b is part of a symbol or a member path
+func isWordByte(b byte) bool {
+	return b == '_' || b == '.' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= 0x80
+}
+
+// lookupPath returns the value of a path of symbols like a.b.c
+func lookupPath(path []string) (reflect.Value, error) {
+	value, err := e.GetValue(path[0])
+	if err != nil {
+		return value, err
+	}
+	for _, name := range path[1:] {
+		value = indirect(value)
+		if module, ok := interfaceOf(value).(*env.Env); ok {
+			value, err = module.GetValue(name)
+			if err != nil {
+				return value, err
+			}
+			continue
+		}
+		if value.Kind() != reflect.Struct {
+			return value, fmt.Errorf("no member %v", name)
+		}
+		value = value.FieldByName(name)
+		if !value.IsValid() {
+			return value, fmt.Errorf("no member %v", name)
+		}
+	}
+	return value, nil
+}
+
+// memberNames returns the names of the members of a module, struct, or map
+func memberNames(value reflect.Value) []string {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if module, ok := interfaceOf(value).(*env.Env); ok {
+		return module.Symbols()
+	}
+
+	var names []string
+	if value.IsValid() {
+		for i := 0; i < value.NumMethod(); i++ {
+			names = append(names, value.Type().Method(i).Name)
+		}
+	}
+	value = indirect(value)
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Type().Field(i); field.PkgPath == "" {
+				names = append(names, field.Name)
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if key.Kind() == reflect.Interface {
+				key = key.Elem()
+			}
+			if key.Kind() == reflect.String {
+				names = append(names, key.String())
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// indirect returns the value that value points to or contains
+func indirect(value reflect.Value) reflect.Value {
+	for (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && !value.IsNil() {
+		if value.Kind() == reflect.Ptr && value.Type().Elem().Kind() != reflect.Struct {
+			break
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// interfaceOf returns the interface of value or nil if it cannot be used
+func interfaceOf(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
+
+// formatValue formats a value for printing in the REPL,
+// strings are quoted and slices, maps, and structs show their elements.
+func formatValue(v interface{}) string {
+	var builder strings.Builder
+	writeValue(&builder, reflect.ValueOf(v), 0)
+	return builder.String()
+}
+
+// writeValue writes a formatted value, depth limits nested values
+func writeValue(builder *strings.Builder, value reflect.Value, depth int) {
+	if !value.IsValid() {
+		builder.WriteString("<nil>")
+		return
+	}
+	if depth > 5 {
+		builder.WriteString("...")
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() {
+			builder.WriteString("<nil>")
+			return
+		}
+		writeValue(builder, value.Elem(), depth)
+		return
+	case reflect.String:
+		builder.WriteString(strconv.Quote(value.String()))
+		return
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		fmt.Fprint(builder, value)
+		return
+	case reflect.Func:
+		if value.IsNil() {
+			builder.WriteString("<nil>")
+		} else if isVMFunction(value.Type()) {
+			builder.WriteString("<function>")
+		} else {
+			builder.WriteString(value.Type().String())
+		}
+		return
+	case reflect.Chan, reflect.UnsafePointer:
+		builder.WriteString(value.Type().String())
+		return
+	}
+
+	if v := interfaceOf(value); v != nil {
+		switch v := v.(type) {
+		case *env.Env:
+			builder.WriteString("module{")
+			builder.WriteString(strings.Join(v.Symbols(), ", "))
+			builder.WriteString("}")
+			return
+		case error:
+			builder.WriteString(v.Error())
+			return
+		case fmt.Stringer:
+			if value.Kind() != reflect.Ptr || !value.IsNil() {
+				builder.WriteString(v.String())
+				return
+			}
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			builder.WriteString("<nil>")
+			return
+		}
+		builder.WriteString("&")
+		writeValue(builder, value.Elem(), depth+1)
+
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			builder.WriteString("[]")
+			return
+		}
+		builder.WriteString("[")
+		for i := 0; i < value.Len(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			if i == 100 {
+				builder.WriteString("...")
+				break
+			}
+			writeValue(builder, value.Index(i), depth+1)
+		}
+		builder.WriteString("]")
+
+	case reflect.Map:
+		keys := make([]string, 0, value.Len())
+		items := make(map[string]string, value.Len())
+		for _, key := range value.MapKeys() {
+			var keyBuilder, itemBuilder strings.Builder
+			writeValue(&keyBuilder, key, depth+1)
+			writeValue(&itemBuilder, value.MapIndex(key), depth+1)
+			keys = append(keys, keyBuilder.String())
+			items[keyBuilder.String()] = itemBuilder.String()
+		}
+		sort.Strings(keys)
+		builder.WriteString("{")
+		for i, key := range keys {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(key)
+			builder.WriteString(": ")
+			builder.WriteString(items[key])
+		}
+		builder.WriteString("}")
+
+	case reflect.Struct:
+		builder.WriteString(value.Type().String())
+		builder.WriteString("{")
+		first := true
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if !first {
+				builder.WriteString(", ")
+			}
+			first = false
+			builder.WriteString(field.Name)
+			builder.WriteString(": ")
+			writeValue(builder, value.Field(i), depth+1)
+		}
+		builder.WriteString("}")
+
+	default:
+		fmt.Fprintf(builder, "%#v", interfaceOf(value))
+	}
+}
+
+// isVMFunction returns true if the function type is the type of functions defined in scripts
+func isVMFunction(funcType reflect.Type) bool {
+	return funcType.NumIn() > 0 && funcType.In(0) == contextType &&
+		funcType.NumOut() == 2 && funcType.Out(0) == reflectValueType && funcType.Out(1) == reflectValueType
+}
+
+// writeAST writes a syntax tree node with its position and its fields that are not empty, one per line
+func writeAST(builder *strings.Builder, value reflect.Value, indent string) {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Kind() == reflect.Ptr && value.IsNil() {
+		builder.WriteString("nil\n")
+		return
+	}
+
+	if value.Type() == reflectValueType {
+		builder.WriteString(formatValue(interfaceOf(value.Interface().(reflect.Value))))
+		builder.WriteString("\n")
+		return
+	}
+
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		if value.Kind() == reflect.String {
+			builder.WriteString(strconv.Quote(value.String()))
+		} else {
+			fmt.Fprint(builder, interfaceOf(value))
+		}
+		builder.WriteString("\n")
+		return
+	}
+
+	builder.WriteString(value.Elem().Type().Name())
+	if pos, ok := interfaceOf(value).(ast.Pos); ok {
+		fmt.Fprintf(builder, " %d:%d", pos.Position().Line, pos.Position().Column)
+	}
+	builder.WriteString("\n")
+
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		fieldValue := value.Field(i)
+		if field.Anonymous || field.PkgPath != "" || isZero(fieldValue) {
+			continue
+		}
+
+		builder.WriteString(indent + "  " + field.Name + ":")
+		if fieldValue.Kind() == reflect.Slice && fieldValue.Type().Elem().Kind() != reflect.String {
+			builder.WriteString("\n")
+			for j := 0; j < fieldValue.Len(); j++ {
+				builder.WriteString(indent + "    - ")
+				writeAST(builder, fieldValue.Index(j), indent+"      ")
+			}
+			continue
+		}
+		builder.WriteString(" ")
+		writeAST(builder, fieldValue, indent+"  ")
+	}
+}
+
+// isZero returns true if value is the zero value of its type
+func isZero(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Struct:
+		if value.Type() == reflectValueType {
+			return !value.Interface().(reflect.Value).IsValid()
+		}
+	}
+	return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
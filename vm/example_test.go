@@ -1,12 +1,16 @@
 package vm_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"sync"
 	"time"
 
+	"github.com/mattn/anko/core"
 	"github.com/mattn/anko/env"
 	"github.com/mattn/anko/vm"
 )
@@ -155,6 +159,179 @@ println("Hello World :)")
 	// output: Hello World :)
 }
 
+func Example_vmOptionsStdout() {
+	// "github.com/mattn/anko/core"
+	// "github.com/mattn/anko/env"
+
+	e := env.NewEnv()
+	core.Import(e)
+
+	script := `
+println("Hello World :)")
+`
+
+	var stdout bytes.Buffer
+	_, err := vm.Execute(e, &vm.Options{Stdout: &stdout}, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	fmt.Printf("%q\n", stdout.String())
+
+	// output: "Hello World :)\n"
+}
+
+func Example_vmOrderedMap() {
+	// "github.com/mattn/anko/core"
+	// "github.com/mattn/anko/env"
+
+	e := env.NewEnv()
+	core.Import(e)
+
+	script := `
+a = @{"c": 1, "b": 2}
+a.a = 3
+delete(a, "c")
+println(keys(a))
+
+b = orderedMap()
+b["z"] = 1
+b["y"] = 2
+for k, v in b {
+	println(k, v)
+}
+
+// with OrderedMaps all map literals without a type are ordered
+c = {"x": 1, "w": 2}
+println(c)
+`
+
+	_, err := vm.Execute(e, &vm.Options{OrderedMaps: true}, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	// output:
+	// [b a]
+	// z 1
+	// y 2
+	// map[x:1 w:2]
+}
+
+func Example_vmDeterministic() {
+	// "github.com/mattn/anko/core"
+	// "github.com/mattn/anko/env"
+	// _ "github.com/mattn/anko/packages"
+
+	e := env.NewEnv()
+	core.Import(e)
+
+	script := `
+time = import("time")
+rand = import("math/rand")
+
+a = {"c": 1, "b": 2, "a": 3}
+println(keys(a))
+for k, v in a {
+	println(k, v)
+}
+println(time.Now().Format(time.RFC3339))
+println(rand.Intn(1000) == rand.Intn(1000))
+`
+
+	options := &vm.Options{
+		Deterministic: true,
+		Clock:         func() time.Time { return time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC) },
+		RandSeed:      1,
+	}
+	_, err := vm.Execute(e, options, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	// output:
+	// [a b c]
+	// a 3
+	// b 2
+	// c 1
+	// 2020-03-01T12:00:00Z
+	// false
+}
+
+func Example_vmContext() {
+	// "github.com/mattn/anko/core"
+	// "github.com/mattn/anko/env"
+
+	e := env.NewEnv()
+	core.Import(e)
+
+	// Go functions with a first parameter of type context.Context get the context of the run
+	err := e.Define("hasDeadline", func(ctx context.Context) bool {
+		_, ok := ctx.Deadline()
+		return ok
+	})
+	if err != nil {
+		log.Fatalf("define error: %v\n", err)
+	}
+	err = e.Define("contextErr", func(ctx context.Context) error {
+		return ctx.Err()
+	})
+	if err != nil {
+		log.Fatalf("define error: %v\n", err)
+	}
+
+	script := `
+println(hasDeadline())
+println(contextErr(context()))
+`
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = vm.ExecuteContext(ctx, e, nil, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	// output:
+	// true
+	// <nil>
+}
+
+func Example_vmBindFunc() {
+	// "github.com/mattn/anko/env"
+
+	e := env.NewEnv()
+
+	script := `
+func greet(name, times) {
+	return "hello " + name + "!" * times
+}
+`
+
+	_, err := vm.Execute(e, nil, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	var greet func(name string, times int) (string, error)
+	err = vm.BindFunc(e, "greet", &greet)
+	if err != nil {
+		log.Fatalf("bind error: %v\n", err)
+	}
+	fmt.Println(greet("anko", 2))
+
+	// or call it without binding
+	scriptGreet, err := e.Get("greet")
+	if err != nil {
+		log.Fatalf("get error: %v\n", err)
+	}
+	fmt.Println(vm.Call(context.Background(), scriptGreet, "anko", 1))
+
+	// output:
+	// hello anko!! <nil>
+	// hello anko! <nil>
+}
+
 func Example_vmQuickStart() {
 	// "github.com/mattn/anko/env"
 
@@ -229,3 +406,39 @@ a(5) // 6
 	// 5.5
 	// 6
 }
+
+func Example_vmServeREPL() {
+	// "github.com/mattn/anko/env"
+
+	e := env.NewEnv()
+	err := e.Define("config", map[string]interface{}{"workers": 4})
+	if err != nil {
+		log.Fatalf("define error: %v\n", err)
+	}
+
+	// a Unix socket can be used with net.Listen("unix", path)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Fatalf("listen error: %v\n", err)
+	}
+	go vm.ServeREPL(listener, e, &vm.ServeREPLOptions{
+		Authenticate: func(password string) bool { return password == "secret" },
+	})
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		log.Fatalf("dial error: %v\n", err)
+	}
+	fmt.Fprint(conn, "secret\nconfig.workers\nconfig.workers = 8\nquit()\n")
+	output, err := ioutil.ReadAll(conn)
+	if err != nil {
+		log.Fatalf("read error: %v\n", err)
+	}
+	fmt.Printf("%s\n", output)
+	listener.Close()
+
+	// output:
+	// password: > 4
+	// > 8
+	// >
+}
@@ -1,9 +1,15 @@
 package vm
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"math/big"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/mattn/anko/env"
 	_ "github.com/mattn/anko/packages"
@@ -37,6 +43,95 @@ func TestImport(t *testing.T) {
 	env.Packages = envPackages
 }
 
+func TestImportReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `a = import("strings"); b = import("strings"); a == b`, RunOutput: true},
+		{Script: `a = import("strings"); a.ToLower = 1`, RunError: fmt.Errorf("env is read-only")},
+		{Script: `a = import("strings"); a.b = 1`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `a = import("strings"); a.b`, RunError: fmt.Errorf("undefined symbol 'b'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestImportRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := env.NewRegistry()
+	registry.Register("testPackage", &env.Package{
+		Values: map[string]reflect.Value{"a": reflect.ValueOf(int64(1))},
+		Types:  map[string]reflect.Type{"b": reflect.TypeOf(int64(1))},
+	})
+	registry.RegisterFunc("testFunc", func() *env.Package {
+		return &env.Package{Values: map[string]reflect.Value{"c": reflect.ValueOf("c")}}
+	})
+	registry.Remove("strings")
+	envSetupFunc := func(t *testing.T, e *env.Env) { e.SetRegistry(registry) }
+
+	tests := []Test{
+		{Script: `a = import("testPackage"); a.a`, RunOutput: int64(1)},
+		{Script: `a = import("testPackage"); b = make(a.b); b`, RunOutput: int64(0)},
+		{Script: `a = import("testFunc"); a.c`, RunOutput: "c"},
+		{Script: `a = import("bytes"); b = make(a.Buffer); b.WriteString("d"); b.String()`, RunOutput: "d"},
+		{Script: `a = import("strings")`, RunError: fmt.Errorf("package not found: strings")},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+}
+
+func TestPackagesStreams(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	e := env.NewEnv()
+	err := e.Define("write", func(ctx context.Context, a ...interface{}) (reflect.Value, reflect.Value) {
+		fmt.Fprint(Stdout(ctx), a...)
+		return nilValue, reflectValueErrorNilValue.Interface().(reflect.Value)
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	script := `
+fmt = import("fmt")
+os = import("os")
+fmt.Print("a")
+fmt.Printf("%v", 1)
+fmt.Println("b")
+os.Stdout.WriteString("c")
+os.Stderr.WriteString("d")
+func f() { write("e") }
+f()
+b = make([]byte, 5)
+n, err = os.Stdin.Read(b)
+b
+`
+	options := &Options{Stdout: &stdout, Stderr: &stderr, Stdin: strings.NewReader("input\n")}
+	value, err := Execute(e, options, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if !reflect.DeepEqual(value, []byte("input")) {
+		t.Errorf("Stdin - received: %#v - expected: %#v", value, []byte("input"))
+	}
+	if stdout.String() != "a1b\nce" {
+		t.Errorf("stdout - received: %q - expected: %q", stdout.String(), "a1b\nce")
+	}
+	if stderr.String() != "d" {
+		t.Errorf("stderr - received: %q - expected: %q", stderr.String(), "d")
+	}
+
+	// without stream options the os streams are used
+	value, err = Execute(env.NewEnv(), nil, `os = import("os"); os.Stdout`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != os.Stdout {
+		t.Errorf("os.Stdout - received: %#v - expected: %#v", value, os.Stdout)
+	}
+}
+
 func TestPackagesBytes(t *testing.T) {
 	t.Parallel()
 
@@ -55,10 +150,71 @@ func TestPackagesJson(t *testing.T) {
 		{Script: `json = import("encoding/json"); b = 1; err = json.Unmarshal(a, &b); err`, Input: map[string]interface{}{"a": []byte(`{"b": "b"}`)}, Output: map[string]interface{}{"a": []byte(`{"b": "b"}`), "b": map[string]interface{}{"b": "b"}}},
 		{Script: `json = import("encoding/json"); b = 1; err = json.Unmarshal(a, &b); err`, Input: map[string]interface{}{"a": `{"b": "b"}`}, Output: map[string]interface{}{"a": `{"b": "b"}`, "b": map[string]interface{}{"b": "b"}}},
 		{Script: `json = import("encoding/json"); b = 1; err = json.Unmarshal(a, &b); err`, Input: map[string]interface{}{"a": `[["1", "2"],["3", "4"]]`}, Output: map[string]interface{}{"a": `[["1", "2"],["3", "4"]]`, "b": []interface{}{[]interface{}{"1", "2"}, []interface{}{"3", "4"}}}},
+
+		{Script: `json = import("encoding/json"); a, err = json.Encode({"b": [1, 2.5, "c", nil, true], 1: {}}); if err != nil { return err }; a`, RunOutput: `{"1":{},"b":[1,2.5,"c",null,true]}`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode([{"b": {"c": [[1]]}}]); if err != nil { return err }; a`, RunOutput: `[{"b":{"c":[[1]]}}]`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(b); if err != nil { return err }; a`, Input: map[string]interface{}{"b": map[int]interface{}{2: "a", 1: nil}}, RunOutput: `{"1":null,"2":"a"}`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(b); if err != nil { return err }; a`, Input: map[string]interface{}{"b": []byte("a")}, RunOutput: `"YQ=="`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(@{"c": 1, "b": @{"a": [1]}, 1: nil}); if err != nil { return err }; a`, RunOutput: `{"c":1,"b":{"a":[1]},"1":null}`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(b); if err != nil { return err }; a`, Input: map[string]interface{}{"b": testJSONStruct{}}, RunOutput: `{"A":0,"b":null,"E":null,"F":"0001-01-01T00:00:00Z"}`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(b); if err != nil { return err }; a`,
+			Input:     map[string]interface{}{"b": &testJSONStruct{testJSONEmbedded: testJSONEmbedded{A: 1}, B: map[interface{}]interface{}{"c": int64(1)}, C: "c", E: big.NewInt(12345678901234567), F: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}},
+			RunOutput: `{"A":1,"b":{"c":1},"c":"c","E":12345678901234567,"F":"2000-01-02T03:04:05Z"}`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode(func() {}); err.Error()`, RunOutput: "json: unsupported type: func(context.Context) (reflect.Value, reflect.Value)"},
+		{Script: `json = import("encoding/json"); a, err = json.EncodeIndent({"b": [1]}, "", "  "); if err != nil { return err }; a`, RunOutput: "{\n  \"b\": [\n    1\n  ]\n}"},
+
+		{Script: `json = import("encoding/json"); a, err = json.Decode(b); if err != nil { return err }; a`, Input: map[string]interface{}{"b": `{"a": [1, 2.5, 1e2, 12345678901234567890, "c", null, true], "b": {}}`},
+			RunOutput: map[interface{}]interface{}{"a": []interface{}{int64(1), 2.5, float64(100), float64(12345678901234567890), "c", nil, true}, "b": map[interface{}]interface{}{}}},
+		{Script: `json = import("encoding/json"); a, err = json.Decode(b); if err != nil { return err }; a.a[0] + 1`, Input: map[string]interface{}{"b": []byte(`{"a": [1]}`)}, RunOutput: int64(2)},
+		{Script: `json = import("encoding/json"); a, err = json.Decode("1 2"); err.Error()`, RunOutput: "invalid character after top-level value"},
+		{Script: `json = import("encoding/json"); a, err = json.Decode("{"); err.Error()`, RunOutput: "unexpected EOF"},
+
+		{Script: `json = import("encoding/json"); bytes = import("bytes"); b = make(bytes.Buffer); e = json.NewValueEncoder(&b); e.SetIndent("", " "); e.SetEscapeHTML(false); e.Encode({"a": "<"}); e.Encode([1]); b.String()`,
+			RunOutput: "{\n \"a\": \"<\"\n}\n[\n 1\n]\n"},
+
+		{Script: `json = import("encoding/json"); a = {}; a.b = a; b, err = json.Encode(a); err.Error()`, RunOutput: "json: unsupported value: encountered a cycle via map[interface {}]interface {}"},
+		{Script: `json = import("encoding/json"); a = [1]; a[0] = a; b, err = json.Encode(a); err.Error()`, RunOutput: "json: unsupported value: encountered a cycle via []interface {}"},
+		{Script: `json = import("encoding/json"); a = @{}; a.b = [a]; b, err = json.EncodeIndent(a, "", " "); err.Error()`, RunOutput: "json: unsupported value: encountered a cycle via *vm.OrderedMap"},
+		{Script: `json = import("encoding/json"); bytes = import("bytes"); b = make(bytes.Buffer); e = json.NewValueEncoder(&b); e.Encode(a).Error()`, Input: map[string]interface{}{"a": testJSONCycle()},
+			RunOutput: "json: unsupported value: encountered a cycle via *vm.testJSONNode"},
+		{Script: `json = import("encoding/json"); a = {}; b, err = json.Encode([a, {"c": a}, a]); if err != nil { return err }; b`, RunOutput: `[{},{"c":{}},{}]`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode({1: "int", "1": "string"}); err.Error()`,
+			RunOutput: `json: unsupported value: keys of map[interface {}]interface {} with the same member name "1"`},
+		{Script: `json = import("encoding/json"); a = @{}; a[1] = "int"; a["1"] = "string"; b, err = json.Encode([a]); err.Error()`,
+			RunOutput: `json: unsupported value: keys of *vm.OrderedMap with the same member name "1"`},
+		{Script: `json = import("encoding/json"); a, err = json.Encode({1: "int", "2": "string"}); if err != nil { return err }; a`, RunOutput: `{"1":"int","2":"string"}`},
+		{Script: `json = import("encoding/json"); strings = import("strings"); d = json.NewValueDecoder(strings.NewReader("{\"a\": 1} [2]")); a = []; for { b, err = d.Decode(); if err != nil { return [a, err.Error()] }; a += b }`,
+			RunOutput: []interface{}{[]interface{}{map[interface{}]interface{}{"a": int64(1)}, int64(2)}, "EOF"}},
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+type testJSONEmbedded struct {
+	A int
+	d int
+}
+
+type testJSONNode struct {
+	*testJSONNode
+	Next *testJSONNode
+}
+
+// testJSONCycle returns a node with a field that points to itself through an embedded node
+func testJSONCycle() *testJSONNode {
+	node := &testJSONNode{testJSONNode: &testJSONNode{}}
+	node.testJSONNode.Next = node
+	return node
+}
+
+type testJSONStruct struct {
+	testJSONEmbedded
+	B map[interface{}]interface{} `json:"b"`
+	C string                      `json:"c,omitempty"`
+	D string                      `json:"-"`
+	E *big.Int
+	F time.Time
+}
+
 func TestPackagesRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -108,6 +264,53 @@ a
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesInterfaceAdapters(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `
+sort = import("sort")
+a = [5, 3, 1, 4, 2]
+sort.Sort({"Len": func() { return len(a) }, "Less": func(i, j) { return a[i] < a[j] }, "Swap": func(i, j) { b = a[i]; a[i] = a[j]; a[j] = b }})
+a
+`,
+			RunOutput: []interface{}{int64(1), int64(2), int64(3), int64(4), int64(5)}},
+		{Script: `
+sort = import("sort")
+module list {
+	a = [5, 3, 1, 4, 2]
+	func Len() { return len(a) }
+	func Less(i, j) { return a[i] > a[j] }
+	func Swap(i, j) { b = a[i]; a[i] = a[j]; a[j] = b }
+}
+sort.Sort(list)
+list.a
+`,
+			RunOutput: []interface{}{int64(5), int64(4), int64(3), int64(2), int64(1)}},
+		{Script: `
+io = import("io")
+ioutil = import("io/ioutil")
+done = false
+func read(p) {
+	if done {
+		return 0, io.EOF
+	}
+	done = true
+	p[0] = 97
+	p[1] = 98
+	return 2, nil
+}
+b, err = ioutil.ReadAll({"Read": read})
+b
+`,
+			RunOutput: []byte("ab")},
+		{Script: `sort = import("sort"); sort.Sort({"Len": func() { return 0 }})`, RunError: fmt.Errorf("function wants argument type sort.Interface but received type map[interface {}]interface {}")},
+		{Script: `sort = import("sort"); sort.Sort({"Len": 1, "Less": 2, "Swap": 3})`, RunError: fmt.Errorf("function wants argument type sort.Interface but received type map[interface {}]interface {}")},
+		{Script: `sort = import("sort"); sort.Sort([1])`, RunError: fmt.Errorf("function wants argument type sort.Interface but received type []interface {}")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesStrconv(t *testing.T) {
 	t.Parallel()
 
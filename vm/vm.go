@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"time"
 
 	"github.com/mattn/anko/ast"
 	"github.com/mattn/anko/env"
@@ -13,14 +15,40 @@ import (
 
 // Options provides options to run VM with
 type Options struct {
-	Debug bool // run in Debug mode
+	Debug    bool      // run in Debug mode
+	Coverage *Coverage // record statement and branch run counts
+	Profiler *Profiler // record run times of lines and VM functions
+	Stdout   io.Writer // stdout of the print builtins and of imported packages, os.Stdout if nil
+	Stderr   io.Writer // stderr of imported packages, os.Stderr if nil
+	Stdin    io.Reader // stdin of imported packages, os.Stdin if nil
+
+	OrderedMaps bool // make OrderedMap values from map literals without a type
+	StrictTypes bool // check the values of type annotations of var statements and functions, see Check
+	Strict      bool // assigning to undefined symbols and lossy conversions of values are errors, set by a "use strict" first statement too
+
+	Deterministic bool             // sorted map iteration, go statements are errors, and Clock and RandSeed for imported time and math/rand packages
+	Clock         func() time.Time // time.Now of imported time packages in Deterministic mode, a fixed time if nil
+	RandSeed      int64            // seed of imported math/rand packages in Deterministic mode
+}
+
+// Container is implemented by Go values that can check if they contain an item,
+// like sets, for the in operator of scripts.
+// The in operator also checks the items of slices and arrays, the keys of maps and the substrings of strings.
+// Channels are not supported, checking them would receive their values.
+type Container interface {
+	Contains(item interface{}) bool
 }
 
 type (
 	// Error is a VM run error.
+	// Pos is where the error happened, also when it happened inside a called script function,
+	// and a panic of a called Go function is an Error at the position of the call.
 	Error struct {
 		Message string
 		Pos     ast.Position
+		Value   interface{} // value of the throw statement, see ThrownValue
+		thrown  bool
+		cause   error // recovered panic of a called function
 	}
 
 	// runInfo provides run incoming and outgoing information
@@ -75,11 +103,34 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-// newError makes VM error from error
+// Unwrap returns the thrown value if it is an error, or the recovered panic of a called function,
+// so errors.Is and errors.As can find it.
+func (e *Error) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return e.cause
+}
+
+// ThrownValue returns the value of the throw statement that err comes from.
+// Rethrowing a caught error keeps the value.
+func ThrownValue(err error) (interface{}, bool) {
+	var vmErr *Error
+	if !errors.As(err, &vmErr) || !vmErr.thrown {
+		return nil, false
+	}
+	return vmErr.Value, true
+}
+
+// newError makes VM error from error.
+// A VM error is returned as is to keep the position where it happened.
 func newError(pos ast.Pos, err error) error {
 	if err == nil {
 		return nil
 	}
+	if vmErr, ok := err.(*Error); ok {
+		return vmErr
+	}
 	if pos == nil {
 		return &Error{Message: err.Error(), Pos: ast.Position{Line: 1, Column: 1}}
 	}
@@ -97,18 +148,46 @@ func newStringError(pos ast.Pos, err string) error {
 	return &Error{Message: err, Pos: pos.Position()}
 }
 
+// newThrownError makes VM error of a thrown value, a thrown VM error keeps its thrown value
+func newThrownError(pos ast.Pos, rv reflect.Value) error {
+	var value interface{}
+	if rv.IsValid() && rv.CanInterface() {
+		value = rv.Interface()
+	}
+	if vmErr, ok := value.(*Error); ok && vmErr.thrown {
+		value = vmErr.Value
+	}
+	return &Error{Message: fmt.Sprint(value), Pos: pos.Position(), Value: value, thrown: true}
+}
+
 func recoverFunc(runInfo *runInfoStruct) {
 	recoverInterface := recover()
 	if recoverInterface == nil {
 		return
 	}
+	runInfo.err = recoverError(recoverInterface)
+}
+
+// recoverCallFunc captures panic of a called function as a VM error at the position of the call.
+// The VM error unwraps to the recovered error.
+func recoverCallFunc(runInfo *runInfoStruct, pos ast.Pos) {
+	recoverInterface := recover()
+	if recoverInterface == nil {
+		return
+	}
+	err := recoverError(recoverInterface)
+	runInfo.err = &Error{Message: err.Error(), Pos: pos.Position(), cause: err}
+}
+
+// recoverError makes an error from a recovered value
+func recoverError(recoverInterface interface{}) error {
 	switch value := recoverInterface.(type) {
 	case *Error:
-		runInfo.err = eris.Wrap(value, "vm script recover")
+		return eris.Wrap(value, "vm script recover")
 	case error:
-		runInfo.err = eris.Wrap(value, "vm error recover")
+		return eris.Wrap(value, "vm error recover")
 	default:
-		runInfo.err = eris.Errorf("vm recover %v", recoverInterface)
+		return eris.Errorf("vm recover %v", recoverInterface)
 	}
 }
 
@@ -125,6 +204,34 @@ func isNil(v reflect.Value) bool {
 	}
 }
 
+// isHashable returns true if v can be a key of a map with interface keys
+func isHashable(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	return !v.IsValid() || v.Kind() == reflect.Interface || v.Type().Comparable()
+}
+
+// exactMapKey returns v converted to keyType and true if the conversion is exact and the key can be looked up,
+// so 1.5 and "1" are not keys of a map[int64]bool that has the key 1
+func exactMapKey(v reflect.Value, keyType reflect.Type) (reflect.Value, bool) {
+	key, err := convertReflectValueToType(v, keyType)
+	if err != nil || !isHashable(key) || checkLossyConversion(v, key) != nil {
+		return key, false
+	}
+	value, keyValue := v, key
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if keyValue.Kind() == reflect.Interface && !keyValue.IsNil() {
+		keyValue = keyValue.Elem()
+	}
+	if value.IsValid() && keyValue.IsValid() && (value.Kind() == reflect.String) != (keyValue.Kind() == reflect.String) {
+		return key, false
+	}
+	return key, true
+}
+
 func isNum(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -135,6 +242,18 @@ func isNum(v reflect.Value) bool {
 	return false
 }
 
+// Equal returns true when a and b are equal with the same rules as the VM == operator.
+func Equal(a interface{}, b interface{}) bool {
+	lhsV, rhsV := nilValue, nilValue
+	if a != nil {
+		lhsV = reflect.ValueOf(a)
+	}
+	if b != nil {
+		rhsV = reflect.ValueOf(b)
+	}
+	return equal(lhsV, rhsV)
+}
+
 // equal returns true when lhsV and rhsV is same value.
 func equal(lhsV, rhsV reflect.Value) bool {
 	lhsIsNil, rhsIsNil := isNil(lhsV), isNil(rhsV)
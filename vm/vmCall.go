@@ -0,0 +1,226 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// Call calls a function, like a script function from an Env, with Go values as arguments.
+// fn can also be the reflect.Value of the function. For Go functions the arguments are converted to the parameter types.
+// The return value is like the one of Execute, a []interface{} when the function returns several values.
+// Errors and panics of the function are returned as error.
+func Call(ctx context.Context, fn interface{}, args ...interface{}) (interface{}, error) {
+	values := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			values[i] = nilValue
+			continue
+		}
+		values[i] = reflect.ValueOf(arg)
+	}
+	rv, err := callFunction(ctx, fn, values)
+	if err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+// BindFunc sets the func that fnPtr points to, like a *func(int, string) (bool, error),
+// to a func that calls the function named symbol in the Env. See BindFuncContext.
+func BindFunc(e *env.Env, symbol string, fnPtr interface{}) error {
+	return BindFuncContext(context.Background(), e, symbol, fnPtr)
+}
+
+// BindFuncContext sets the func that fnPtr points to, to a func that calls the function named symbol in the Env with ctx.
+// The arguments and return values are converted to and from the types of the func.
+// When the last return value of the func is an error, errors of calls are returned as it, else they panic.
+func BindFuncContext(ctx context.Context, e *env.Env, symbol string, fnPtr interface{}) error {
+	ptr := reflect.ValueOf(fnPtr)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("BindFunc wants a pointer to a func but received type %T", fnPtr)
+	}
+	fn, err := e.GetValue(symbol)
+	if err != nil {
+		return err
+	}
+	if fn.Kind() == reflect.Interface && !fn.IsNil() {
+		fn = fn.Elem()
+	}
+	if fn.Kind() != reflect.Func {
+		return fmt.Errorf("cannot call type %v", fn.Kind())
+	}
+
+	rt := ptr.Elem().Type()
+	returnsError := rt.NumOut() > 0 && rt.Out(rt.NumOut()-1) == errorType
+	bindFunction := func(in []reflect.Value) []reflect.Value {
+		if rt.IsVariadic() {
+			// pass on the variadic arguments one by one
+			last := in[len(in)-1]
+			in = in[:len(in)-1]
+			for i := 0; i < last.Len(); i++ {
+				in = append(in, last.Index(i))
+			}
+		}
+		rv, err := callFunction(ctx, fn, in)
+		var out []reflect.Value
+		if err == nil {
+			out, err = callResults(rv, rt, returnsError)
+		}
+		if err == nil {
+			return out
+		}
+		if !returnsError {
+			panic(err)
+		}
+		out = make([]reflect.Value, rt.NumOut())
+		for i := 0; i < len(out)-1; i++ {
+			out[i] = reflect.Zero(rt.Out(i))
+		}
+		out[len(out)-1] = reflect.ValueOf(&err).Elem()
+		return out
+	}
+	ptr.Elem().Set(reflect.MakeFunc(rt, bindFunction))
+	return nil
+}
+
+// callFunction calls fn with args and returns the return value in normal VM reflect.Value form
+func callFunction(ctx context.Context, fn interface{}, args []reflect.Value) (rv reflect.Value, err error) {
+	f, ok := fn.(reflect.Value)
+	if !ok {
+		f = reflect.ValueOf(fn)
+	}
+	if f.Kind() == reflect.Interface && !f.IsNil() {
+		f = f.Elem()
+	}
+	if f.Kind() != reflect.Func {
+		return nilValue, fmt.Errorf("cannot call type %v", f.Kind())
+	}
+	if f.IsNil() {
+		return nilValue, fmt.Errorf("cannot call nil func")
+	}
+
+	fType := f.Type()
+	isRunVMFunction := checkIfRunVMFunction(fType)
+	numIn := fType.NumIn()
+	offset := 0
+	if isRunVMFunction {
+		// for runVMFunction the first arg is context so does not count against number of args
+		numIn--
+		offset = 1
+	}
+	if (!fType.IsVariadic() && len(args) != numIn) || (fType.IsVariadic() && len(args) < numIn-1) {
+		return nilValue, fmt.Errorf("function wants %v arguments but received %v", numIn, len(args))
+	}
+
+	in := make([]reflect.Value, 0, len(args)+offset)
+	if isRunVMFunction {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	for i, arg := range args {
+		var argType reflect.Type
+		if fType.IsVariadic() && i >= numIn-1 {
+			argType = fType.In(fType.NumIn() - 1).Elem()
+		} else if isRunVMFunction {
+			// runVMFunction wants the double reflect.ValueOf
+			in = append(in, reflect.ValueOf(arg))
+			continue
+		} else {
+			argType = fType.In(i + offset)
+		}
+		value, err := convertReflectValueToType(arg, argType)
+		if err != nil {
+			return nilValue, fmt.Errorf("function wants argument type %v but received type %v", argType, arg.Type())
+		}
+		in = append(in, value)
+	}
+
+	defer func() {
+		if recoverInterface := recover(); recoverInterface != nil {
+			rv = nilValue
+			err = recoverError(recoverInterface)
+		}
+	}()
+
+	return processCallReturnValues(f.Call(in), isRunVMFunction, true, nil)
+}
+
+// callResults converts the return value of a call in normal VM reflect.Value form to the return values of the func type rt.
+// When returnsError is true, a returned error is returned as error.
+func callResults(rv reflect.Value, rt reflect.Type, returnsError bool) ([]reflect.Value, error) {
+	numOut := rt.NumOut()
+	numValues := numOut
+	if returnsError {
+		numValues--
+	}
+	out := make([]reflect.Value, numOut)
+	if returnsError {
+		out[numOut-1] = reflect.Zero(errorType)
+	}
+	if numValues < 1 && !returnsError {
+		return out, nil
+	}
+
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	values := []reflect.Value{rv}
+	if numOut > 1 && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && (numValues > 1 || isValueAndError(rv, rt.Out(0))) {
+		// several return values
+		values = make([]reflect.Value, rv.Len())
+		for i := range values {
+			values[i] = rv.Index(i)
+		}
+	}
+	if len(values) < numValues {
+		return nil, fmt.Errorf("function wants %v return values but received %v values", numValues, len(values))
+	}
+
+	for i := 0; i < numValues; i++ {
+		value, err := convertReflectValueToType(values[i], rt.Out(i))
+		if err != nil {
+			return nil, fmt.Errorf("function wants return type %v but received type %v", rt.Out(i), values[i].Type())
+		}
+		out[i] = value
+	}
+
+	if returnsError && len(values) > numValues {
+		value := values[numValues]
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		if value.IsValid() && !isNil(value) {
+			err, ok := value.Interface().(error)
+			if ok {
+				return nil, err
+			}
+			if numValues > 0 {
+				return nil, fmt.Errorf("function wants return type error but received type %v", value.Type())
+			}
+			// the value of the last statement of a function that returns only an error
+		}
+	}
+	return out, nil
+}
+
+// isValueAndError returns true if rv, a slice or array returned by a script function, is a value of type rt and an error or nil,
+// so a returned slice like [1, 2] is not taken as a value and an error
+func isValueAndError(rv reflect.Value, rt reflect.Type) bool {
+	if rv.Len() != 2 {
+		return false
+	}
+	if _, err := convertReflectValueToType(rv.Index(0), rt); err != nil {
+		return false
+	}
+	value := rv.Index(1)
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || isNil(value) {
+		return true
+	}
+	_, ok := value.Interface().(error)
+	return ok
+}
@@ -0,0 +1,211 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("goAdd", func(a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, `
+func add(a, b) { return a + b }
+func two(a) { return a, a + 1 }
+func sum(a...) { return len(a) }
+func fail() { return 1++ }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	tests := []struct {
+		name   string
+		args   []interface{}
+		output interface{}
+		err    string
+	}{
+		{name: "add", args: []interface{}{int64(1), int64(2)}, output: int64(3)},
+		{name: "add", args: []interface{}{"a", "b"}, output: "ab"},
+		{name: "two", args: []interface{}{int64(1)}, output: []interface{}{int64(1), int64(2)}},
+		{name: "sum", args: []interface{}{int64(1), nil, "c"}, output: int64(3)},
+		{name: "sum", output: int64(0)},
+		{name: "goAdd", args: []interface{}{int64(1), float64(2)}, output: 3},
+		{name: "add", args: []interface{}{int64(1)}, err: "function wants 2 arguments but received 1"},
+		{name: "goAdd", args: []interface{}{int64(1), "b"}, err: "function wants argument type int but received type string"},
+		{name: "fail", err: "invalid operation"},
+	}
+	for _, test := range tests {
+		fn, err := e.Get(test.name)
+		if err != nil {
+			t.Fatal("Get error:", err)
+		}
+		output, err := Call(context.Background(), fn, test.args...)
+		if err != nil || test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Call %v %v error - received: %v - expected: %v", test.name, test.args, err, test.err)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(output, test.output) {
+			t.Errorf("Call %v %v - received: %#v - expected: %#v", test.name, test.args, output, test.output)
+		}
+	}
+
+	_, err = Call(context.Background(), 1)
+	if err == nil || err.Error() != "cannot call type int" {
+		t.Errorf("Call error - received: %v - expected: %v", err, "cannot call type int")
+	}
+	_, err = Call(context.Background(), func() { panic("a") })
+	if err == nil || !strings.Contains(err.Error(), "vm recover a") {
+		t.Errorf("Call panic error - received: %v - expected: %v", err, "vm recover a")
+	}
+}
+
+func TestBindFunc(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("errorA", errors.New("a"))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, `
+func check(a, b) { if a < 0 { return false, errorA }; return len(b) == a, nil }
+func length(b) { return len(b) }
+func join(a, b...) { return a + len(b) }
+func fail() { return 1++ }
+func set() { c = 1 }
+func list(a) { if a < 0 { return [], errorA }; if a == 0 { return [1, 2], nil }; return [1, 2] }
+func same(a) { return a }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var check func(int, string) (bool, error)
+	err = BindFunc(e, "check", &check)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	ok, err := check(2, "ab")
+	if !ok || err != nil {
+		t.Errorf("check - received: %v, %v - expected: %v, %v", ok, err, true, nil)
+	}
+	ok, err = check(-1, "ab")
+	if ok || err == nil || err.Error() != "a" {
+		t.Errorf("check - received: %v, %v - expected: %v, %v", ok, err, false, "a")
+	}
+
+	var length func(string) (int, error)
+	err = BindFunc(e, "length", &length)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	n, err := length("abc")
+	if n != 3 || err != nil {
+		t.Errorf("length - received: %v, %v - expected: %v, %v", n, err, 3, nil)
+	}
+
+	var join func(int64, ...string) int64
+	err = BindFunc(e, "join", &join)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	if n := join(1, "a", "b"); n != 3 {
+		t.Errorf("join - received: %v - expected: %v", n, 3)
+	}
+
+	var fail func() error
+	err = BindFunc(e, "fail", &fail)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	err = fail()
+	if err == nil || err.Error() != "invalid operation" {
+		t.Errorf("fail - received: %v - expected: %v", err, "invalid operation")
+	}
+
+	var set func() error
+	err = BindFunc(e, "set", &set)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	if err = set(); err != nil {
+		t.Errorf("set - received: %v - expected: %v", err, nil)
+	}
+
+	// a returned slice is one value unless it is a value and an error
+	var list func(int) ([]int64, error)
+	err = BindFunc(e, "list", &list)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	for _, a := range []int{0, 1} {
+		values, err := list(a)
+		if !reflect.DeepEqual(values, []int64{1, 2}) || err != nil {
+			t.Errorf("list - received: %v, %v - expected: %v, %v", values, err, []int64{1, 2}, nil)
+		}
+	}
+	values, err := list(-1)
+	if values != nil || err == nil || err.Error() != "a" {
+		t.Errorf("list - received: %v, %v - expected: %v, %v", values, err, nil, "a")
+	}
+
+	var same func([]interface{}) ([]interface{}, error)
+	err = BindFunc(e, "same", &same)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	items, err := same([]interface{}{"a", "b"})
+	if !reflect.DeepEqual(items, []interface{}{"a", "b"}) || err != nil {
+		t.Errorf("same - received: %v, %v - expected: %v, %v", items, err, []interface{}{"a", "b"}, nil)
+	}
+
+	var wrongType func(string) (bool, error)
+	err = BindFunc(e, "length", &wrongType)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	_, err = wrongType("a")
+	if err == nil || err.Error() != "function wants return type bool but received type int64" {
+		t.Errorf("wrongType - received: %v - expected: %v", err, "function wants return type bool but received type int64")
+	}
+
+	var panics func() int
+	err = BindFunc(e, "fail", &panics)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	func() {
+		defer func() {
+			if recoverInterface := recover(); fmt.Sprint(recoverInterface) != "invalid operation" {
+				t.Errorf("panics - received: %v - expected: %v", recoverInterface, "invalid operation")
+			}
+		}()
+		panics()
+	}()
+
+	err = BindFunc(e, "check", check)
+	if err == nil || err.Error() != "BindFunc wants a pointer to a func but received type func(int, string) (bool, error)" {
+		t.Errorf("BindFunc error - received: %v - expected: %v", err, "BindFunc wants a pointer to a func")
+	}
+	err = BindFunc(e, "foo", &check)
+	if err == nil || err.Error() != "undefined symbol 'foo'" {
+		t.Errorf("BindFunc error - received: %v - expected: %v", err, "undefined symbol 'foo'")
+	}
+	err = BindFunc(e, "errorA", &check)
+	if err == nil || err.Error() != "cannot call type ptr" {
+		t.Errorf("BindFunc error - received: %v - expected: %v", err, "cannot call type ptr")
+	}
+}
@@ -0,0 +1,811 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+)
+
+// checker checks the type annotations of a script, see Check
+type checker struct {
+	runInfo *runInfoStruct
+	scopes  []map[string]*checkSymbol
+	funcs   []*ast.FuncExpr
+	types   map[*ast.TypeStruct]reflect.Type
+	imports map[*ast.ImportExpr]*env.Env
+	errs    []error
+}
+
+// checkSymbol is what the checker knows about a script symbol
+type checkSymbol struct {
+	t         reflect.Type  // static type, nil when not known
+	annotated bool          // t comes from a type annotation
+	fn        *ast.FuncExpr // script function
+	pack      *env.Env      // imported package
+	constant  bool          // declared with const
+}
+
+// Check checks the type annotations of a script without running it and returns the errors found.
+// The types of annotations are looked up in e, like the types of make.
+// Values of annotated vars, arguments of annotated function parameters and return values are checked
+// when their types are known, from literals, annotations, Go functions defined in e and imported packages.
+// Calls to Go functions are checked against their signatures.
+// Everything else is dynamically typed and not checked. Check does not change e.
+func Check(e *env.Env, stmt ast.Stmt) []error {
+	if stmt == nil {
+		return nil
+	}
+	c := &checker{
+		runInfo: &runInfoStruct{ctx: context.Background(), env: e.NewEnv(), options: &Options{}, rv: nilValue},
+		types:   make(map[*ast.TypeStruct]reflect.Type),
+		imports: make(map[*ast.ImportExpr]*env.Env),
+	}
+	c.pushScope()
+	c.checkStmt(stmt)
+	return c.errs
+}
+
+func (c *checker) errorf(pos ast.Pos, format string, a ...interface{}) {
+	c.errs = append(c.errs, newStringError(pos, fmt.Sprintf(format, a...)))
+}
+
+func (c *checker) pushScope() {
+	c.scopes = append(c.scopes, make(map[string]*checkSymbol))
+}
+
+func (c *checker) popScope() {
+	c.scopes = c.scopes[:len(c.scopes)-1]
+}
+
+func (c *checker) define(name string, symbol *checkSymbol) {
+	c.scopes[len(c.scopes)-1][name] = symbol
+}
+
+// lookup returns the symbol of name from the script scopes or from the Go functions of the env
+func (c *checker) lookup(name string) (*checkSymbol, bool) {
+	if symbol, ok := c.lookupScopes(name); ok {
+		return symbol, true
+	}
+	value, err := c.runInfo.env.GetValue(name)
+	if err != nil {
+		return nil, false
+	}
+	if packEnv, ok := interfaceOf(value).(*env.Env); ok {
+		return &checkSymbol{pack: packEnv}, true
+	}
+	// other values of the env can be replaced by the script, only Go functions are typed
+	t := valueType(value)
+	if t != nil && t.Kind() != reflect.Func {
+		t = nil
+	}
+	return &checkSymbol{t: t}, true
+}
+
+// valueType returns the type of a Go value, nil for nil and script functions
+func valueType(value reflect.Value) reflect.Type {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Kind() == reflect.Interface || (value.Kind() == reflect.Func && checkIfRunVMFunction(value.Type())) {
+		return nil
+	}
+	return value.Type()
+}
+
+// annotation returns the type of a type annotation, errors are added once at pos
+func (c *checker) annotation(pos ast.Pos, typeStruct *ast.TypeStruct) reflect.Type {
+	if typeStruct == nil {
+		return nil
+	}
+	if t, ok := c.types[typeStruct]; ok {
+		return t
+	}
+	t := makeType(c.runInfo, typeStruct)
+	if c.runInfo.err != nil {
+		c.errs = append(c.errs, newError(pos, c.runInfo.err))
+		c.runInfo.err = nil
+		t = nil
+	}
+	c.types[typeStruct] = t
+	return t
+}
+
+// block checks stmt in a new scope
+func (c *checker) block(stmt ast.Stmt) {
+	if stmt == nil {
+		return
+	}
+	c.pushScope()
+	c.checkStmt(stmt)
+	c.popScope()
+}
+
+func (c *checker) checkStmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.StmtsStmt:
+		for _, s := range stmt.Stmts {
+			c.checkStmt(s)
+		}
+	case *ast.ExprStmt:
+		c.typeOf(stmt.Expr)
+	case *ast.VarStmt:
+		c.checkVar(stmt)
+	case *ast.LetsStmt:
+		c.checkLets(stmt.LHSS, stmt.RHSS)
+	case *ast.LetMapItemStmt:
+		c.typeOf(stmt.RHS)
+		c.assignUnknown(stmt.LHSS)
+	case *ast.IfStmt:
+		c.typeOf(stmt.If)
+		c.block(stmt.Then)
+		for _, s := range stmt.ElseIf {
+			c.checkStmt(s)
+		}
+		c.block(stmt.Else)
+	case *ast.TryStmt:
+		c.block(stmt.Try)
+		for _, s := range stmt.Catches {
+			catchStmt := s.(*ast.CatchStmt)
+			c.pushScope()
+			c.annotation(catchStmt, catchStmt.TypeData)
+			if catchStmt.Var != "" {
+				c.define(catchStmt.Var, &checkSymbol{})
+			}
+			c.typeOf(catchStmt.Cond)
+			c.checkStmt(catchStmt.Stmt)
+			c.popScope()
+		}
+		c.block(stmt.Finally)
+	case *ast.ForStmt:
+		c.typeOf(stmt.Value)
+		c.pushScope()
+		for _, name := range stmt.Vars {
+			c.define(name, &checkSymbol{})
+		}
+		c.checkStmt(stmt.Stmt)
+		c.popScope()
+	case *ast.CForStmt:
+		c.pushScope()
+		c.checkStmt(stmt.Stmt1)
+		c.typeOf(stmt.Expr2)
+		c.typeOf(stmt.Expr3)
+		c.block(stmt.Stmt)
+		c.popScope()
+	case *ast.LoopStmt:
+		c.typeOf(stmt.Expr)
+		c.block(stmt.Stmt)
+	case *ast.ReturnStmt:
+		c.checkReturn(stmt)
+	case *ast.ThrowStmt:
+		c.typeOf(stmt.Expr)
+	case *ast.ModuleStmt:
+		c.block(stmt.Stmt)
+	case *ast.SwitchStmt:
+		c.typeOf(stmt.Expr)
+		for _, s := range stmt.Cases {
+			caseStmt := s.(*ast.SwitchCaseStmt)
+			for _, expr := range caseStmt.Exprs {
+				c.typeOf(expr)
+			}
+			c.block(caseStmt.Stmt)
+		}
+		c.block(stmt.Default)
+	case *ast.GoroutineStmt:
+		c.typeOf(stmt.Expr)
+	case *ast.DeleteStmt:
+		c.typeOf(stmt.Item)
+		c.typeOf(stmt.Key)
+	case *ast.CloseStmt:
+		c.typeOf(stmt.Expr)
+	case *ast.ChanStmt:
+		c.typeOf(stmt.RHS)
+		c.assignUnknown([]ast.Expr{stmt.LHS, stmt.OkExpr})
+	}
+}
+
+func (c *checker) checkVar(stmt *ast.VarStmt) {
+	types := c.typesOf(stmt.Exprs)
+	what := "var "
+	if stmt.Const {
+		what = "const "
+	}
+	for i, name := range stmt.Names {
+		symbol := &checkSymbol{}
+		if len(stmt.Exprs) == len(stmt.Names) {
+			symbol = c.valueSymbol(name, stmt.Exprs[i])
+		}
+		if stmt.Types != nil && stmt.Types[i] != nil {
+			symbol = &checkSymbol{t: c.annotation(stmt, stmt.Types[i]), annotated: true}
+			if len(stmt.Exprs) == len(stmt.Names) {
+				c.checkAssignable(stmt.Exprs[i], types[i], symbol.t, what+name)
+			}
+		}
+		symbol.constant = stmt.Const
+		c.define(name, symbol)
+	}
+}
+
+func (c *checker) checkLets(lhss []ast.Expr, rhss []ast.Expr) {
+	types := c.typesOf(rhss)
+	for i, lhs := range lhss {
+		identExpr, ok := lhs.(*ast.IdentExpr)
+		if !ok {
+			c.typeOf(lhs)
+			continue
+		}
+		if len(rhss) != len(lhss) {
+			c.assignUnknown([]ast.Expr{lhs})
+			continue
+		}
+		symbol, ok := c.lookupScopes(identExpr.Lit)
+		switch {
+		case (ok && symbol.constant) || (!ok && c.runInfo.env.IsConst(identExpr.Lit)):
+			c.errorf(lhs, "cannot assign to constant '%v'", identExpr.Lit)
+		case !ok:
+			c.define(identExpr.Lit, c.valueSymbol(identExpr.Lit, rhss[i]))
+		case symbol.annotated:
+			c.checkAssignable(rhss[i], types[i], symbol.t, "assignment to "+identExpr.Lit)
+		default:
+			*symbol = *c.valueSymbol(identExpr.Lit, rhss[i])
+		}
+	}
+}
+
+// lookupScopes returns the symbol of name from the script scopes
+func (c *checker) lookupScopes(name string) (*checkSymbol, bool) {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if symbol, ok := c.scopes[i][name]; ok {
+			return symbol, true
+		}
+	}
+	return nil, false
+}
+
+// assignUnknown defines the identifiers of exprs that are not defined yet with unknown types
+func (c *checker) assignUnknown(exprs []ast.Expr) {
+	for _, expr := range exprs {
+		identExpr, ok := expr.(*ast.IdentExpr)
+		if !ok {
+			c.typeOf(expr)
+			continue
+		}
+		if symbol, ok := c.lookupScopes(identExpr.Lit); !ok || !symbol.annotated {
+			c.define(identExpr.Lit, &checkSymbol{})
+		}
+	}
+}
+
+// valueSymbol returns the symbol of name when it gets the value of expr.
+// Imported packages are defined in the env of the checker, so their types and functions can be found.
+func (c *checker) valueSymbol(name string, expr ast.Expr) *checkSymbol {
+	switch expr := expr.(type) {
+	case *ast.FuncExpr:
+		return &checkSymbol{fn: expr}
+	case *ast.ImportExpr:
+		if packEnv := c.importEnv(expr); packEnv != nil {
+			c.runInfo.env.DefineValue(name, reflect.ValueOf(packEnv))
+			return &checkSymbol{pack: packEnv}
+		}
+	}
+	return &checkSymbol{}
+}
+
+// importEnv returns the env of an imported package when the name is a string literal, errors are added once
+func (c *checker) importEnv(expr *ast.ImportExpr) *env.Env {
+	if packEnv, ok := c.imports[expr]; ok {
+		return packEnv
+	}
+	c.imports[expr] = nil
+	literalExpr, ok := expr.Name.(*ast.LiteralExpr)
+	if !ok || literalExpr.Literal.Kind() != reflect.String {
+		return nil
+	}
+	name := literalExpr.Literal.String()
+	pack, ok := c.runInfo.env.LookupPackage(name)
+	if !ok {
+		c.errorf(expr, "package not found: %v", name)
+		return nil
+	}
+	packEnv, err := pack.Env()
+	if err != nil {
+		c.errorf(expr, "import %v", err)
+		return nil
+	}
+	c.imports[expr] = packEnv
+	return packEnv
+}
+
+func (c *checker) checkReturn(stmt *ast.ReturnStmt) {
+	types := c.typesOf(stmt.Exprs)
+	if len(c.funcs) == 0 {
+		return
+	}
+	funcExpr := c.funcs[len(c.funcs)-1]
+	if len(funcExpr.ReturnTypes) == 0 {
+		return
+	}
+	if len(stmt.Exprs) != len(funcExpr.ReturnTypes) {
+		if len(stmt.Exprs) != 1 || types[0] != nil {
+			c.errorf(stmt, "function wants %v return values but received %v values", len(funcExpr.ReturnTypes), len(stmt.Exprs))
+		}
+		return
+	}
+	for i, expr := range stmt.Exprs {
+		c.checkAssignable(expr, types[i], c.annotation(funcExpr, funcExpr.ReturnTypes[i]), "return value")
+	}
+}
+
+// checkAssignable adds an error if a value of type from cannot be used as type to
+func (c *checker) checkAssignable(pos ast.Pos, from reflect.Type, to reflect.Type, what string) {
+	if from == nil || to == nil || typeAssignable(from, to) {
+		return
+	}
+	c.errorf(pos, "cannot use type %v as type %v in %v", from, to, what)
+}
+
+func (c *checker) typesOf(exprs []ast.Expr) []reflect.Type {
+	types := make([]reflect.Type, len(exprs))
+	for i, expr := range exprs {
+		types[i] = c.typeOf(expr)
+	}
+	return types
+}
+
+// typeOf checks expr and returns its static type, nil when it is not known
+func (c *checker) typeOf(expr ast.Expr) reflect.Type {
+	switch expr := expr.(type) {
+	case nil:
+		return nil
+	case *ast.LiteralExpr:
+		if !expr.Literal.IsValid() || (expr.Literal.Kind() == reflect.Interface && expr.Literal.IsNil()) {
+			return nil
+		}
+		return expr.Literal.Type()
+	case *ast.IdentExpr:
+		if symbol, ok := c.lookup(expr.Lit); ok {
+			return symbol.t
+		}
+	case *ast.ParenExpr:
+		return c.typeOf(expr.SubExpr)
+	case *ast.OpExpr:
+		return c.operatorType(expr.Op)
+	case *ast.UnaryExpr:
+		t := c.typeOf(expr.Expr)
+		if expr.Operator == "!" {
+			return reflect.TypeOf(true)
+		}
+		return t
+	case *ast.ArrayExpr:
+		c.typesOf(expr.Exprs)
+		if expr.TypeData != nil {
+			return c.annotation(expr, expr.TypeData)
+		}
+		return interfaceSliceType
+	case *ast.MapExpr:
+		c.typesOf(expr.Keys)
+		c.typesOf(expr.Values)
+		if expr.Ordered {
+			return orderedMapType
+		}
+		if expr.TypeData != nil {
+			return c.annotation(expr, expr.TypeData)
+		}
+	case *ast.MakeExpr:
+		c.typeOf(expr.LenExpr)
+		c.typeOf(expr.CapExpr)
+		return c.annotation(expr, expr.TypeData)
+	case *ast.MakeTypeExpr:
+		c.typeOf(expr.Type)
+	case *ast.LenExpr:
+		c.typeOf(expr.Expr)
+		return reflect.TypeOf(int64(0))
+	case *ast.IncludeExpr:
+		c.typeOf(expr.ItemExpr)
+		c.typeOf(expr.ListExpr)
+		return reflect.TypeOf(true)
+	case *ast.ItemExpr:
+		t := c.typeOf(expr.Item)
+		c.typeOf(expr.Index)
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map) && t.Elem() != interfaceType {
+			return t.Elem()
+		}
+	case *ast.SliceExpr:
+		t := c.typeOf(expr.Item)
+		c.typeOf(expr.Begin)
+		c.typeOf(expr.End)
+		c.typeOf(expr.Cap)
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.String) {
+			return t
+		}
+	case *ast.MemberExpr:
+		return c.memberType(expr)
+	case *ast.FuncExpr:
+		c.checkFunc(expr)
+	case *ast.CallExpr:
+		if expr.Func.IsValid() {
+			c.typesOf(expr.SubExprs)
+			return nil
+		}
+		symbol, _ := c.lookup(expr.Name)
+		return c.checkCall(expr, symbol, expr.SubExprs, expr.VarArg, expr.Raise)
+	case *ast.AnonCallExpr:
+		var symbol *checkSymbol
+		if funcExpr, ok := expr.Expr.(*ast.FuncExpr); ok {
+			c.checkFunc(funcExpr)
+			symbol = &checkSymbol{fn: funcExpr}
+		} else {
+			symbol = &checkSymbol{t: c.typeOf(expr.Expr)}
+		}
+		return c.checkCall(expr, symbol, expr.SubExprs, expr.VarArg, expr.Raise || c.raisesErrors(expr.Expr))
+	case *ast.LetsExpr:
+		c.checkLets(expr.LHSS, expr.RHSS)
+	case *ast.TernaryOpExpr:
+		c.typeOf(expr.Expr)
+		lhs := c.typeOf(expr.LHS)
+		if rhs := c.typeOf(expr.RHS); lhs == rhs {
+			return lhs
+		}
+	case *ast.NilCoalescingOpExpr:
+		c.typeOf(expr.LHS)
+		c.typeOf(expr.RHS)
+	case *ast.ImportExpr:
+		c.typeOf(expr.Name)
+		c.importEnv(expr)
+	case *ast.ChanExpr:
+		c.typeOf(expr.LHS)
+		c.typeOf(expr.RHS)
+	case *ast.AddrExpr:
+		c.typeOf(expr.Expr)
+	case *ast.DerefExpr:
+		c.typeOf(expr.Expr)
+	case *ast.DeleteStmt:
+		c.typeOf(expr.Item)
+		c.typeOf(expr.Key)
+	case *ast.ChanStmt:
+		c.typeOf(expr.RHS)
+		c.assignUnknown([]ast.Expr{expr.LHS, expr.OkExpr})
+	}
+	return nil
+}
+
+// operatorType checks the operands of an operator and returns the type of the result when it is known
+func (c *checker) operatorType(operator ast.Operator) reflect.Type {
+	switch operator := operator.(type) {
+	case *ast.BinaryOperator:
+		c.typeOf(operator.LHS)
+		c.typeOf(operator.RHS)
+		return reflect.TypeOf(true)
+	case *ast.ComparisonOperator:
+		c.typeOf(operator.LHS)
+		c.typeOf(operator.RHS)
+		return reflect.TypeOf(true)
+	case *ast.AddOperator:
+		lhs := c.typeOf(operator.LHS)
+		rhs := c.typeOf(operator.RHS)
+		if lhs != rhs || lhs == nil {
+			return nil
+		}
+		if lhs.Kind() == reflect.Int64 || lhs.Kind() == reflect.Float64 || (lhs.Kind() == reflect.String && operator.Operator == "+") {
+			return lhs
+		}
+	case *ast.MultiplyOperator:
+		c.typeOf(operator.LHS)
+		c.typeOf(operator.RHS)
+	}
+	return nil
+}
+
+// memberType returns the type of a member of an imported package, or of a field of a struct with a known type
+func (c *checker) memberType(expr *ast.MemberExpr) reflect.Type {
+	if identExpr, ok := expr.Expr.(*ast.IdentExpr); ok {
+		if symbol, ok := c.lookup(identExpr.Lit); ok && symbol.pack != nil {
+			value, err := symbol.pack.GetValue(expr.Name)
+			if err != nil {
+				c.errs = append(c.errs, newError(expr, err))
+				return nil
+			}
+			return valueType(value)
+		}
+	}
+	t := c.typeOf(expr.Expr)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t != nil && t.Kind() == reflect.Struct {
+		if field, ok := t.FieldByName(expr.Name); ok && field.PkgPath == "" {
+			return field.Type
+		}
+	}
+	return nil
+}
+
+// checkFunc checks the annotations and statements of a script function
+func (c *checker) checkFunc(funcExpr *ast.FuncExpr) {
+	if funcExpr.Name != "" {
+		c.define(funcExpr.Name, &checkSymbol{fn: funcExpr})
+	}
+	c.pushScope()
+	for i, name := range funcExpr.Params {
+		symbol := &checkSymbol{}
+		if funcExpr.ParamTypes != nil && funcExpr.ParamTypes[i] != nil {
+			symbol = &checkSymbol{t: c.annotation(funcExpr, funcExpr.ParamTypes[i]), annotated: true}
+			if symbol.t != nil && funcExpr.VarArg && i == len(funcExpr.Params)-1 {
+				symbol.t = reflect.SliceOf(symbol.t)
+			}
+		}
+		c.define(name, symbol)
+	}
+	for _, typeStruct := range funcExpr.ReturnTypes {
+		c.annotation(funcExpr, typeStruct)
+	}
+	c.funcs = append(c.funcs, funcExpr)
+	c.checkStmt(funcExpr.Stmt)
+	c.funcs = c.funcs[:len(c.funcs)-1]
+	c.popScope()
+}
+
+// raisesErrors returns true if expr is a function of an imported package with RaiseErrors
+func (c *checker) raisesErrors(expr ast.Expr) bool {
+	if memberExpr, ok := expr.(*ast.MemberExpr); ok {
+		if identExpr, ok := memberExpr.Expr.(*ast.IdentExpr); ok {
+			symbol, ok := c.lookup(identExpr.Lit)
+			return ok && symbol.pack != nil && symbol.pack.RaisesErrors()
+		}
+	}
+	return false
+}
+
+// checkCall checks the arguments of a call to a Go or annotated script function and returns the type of the result when it is known
+func (c *checker) checkCall(pos ast.Pos, symbol *checkSymbol, args []ast.Expr, varArg bool, raise bool) reflect.Type {
+	types := c.typesOf(args)
+	if symbol == nil {
+		return nil
+	}
+
+	if funcExpr := symbol.fn; funcExpr != nil {
+		numIn := len(funcExpr.Params)
+		if wrongNumberOfArgs(numIn, funcExpr.VarArg, len(args), varArg) {
+			c.errorf(pos, "function wants %v arguments but received %v", numIn, len(args))
+			return nil
+		}
+		if funcExpr.ParamTypes != nil && !varArg {
+			for i, t := range types {
+				index := i
+				if index >= numIn {
+					index = numIn - 1
+				}
+				if funcExpr.ParamTypes[index] != nil {
+					c.checkAssignable(args[i], t, c.annotation(funcExpr, funcExpr.ParamTypes[index]), "argument "+funcExpr.Params[index])
+				}
+			}
+		}
+		if len(funcExpr.ReturnTypes) == 1 {
+			return c.annotation(funcExpr, funcExpr.ReturnTypes[0])
+		}
+		return nil
+	}
+
+	rt := symbol.t
+	if rt == nil || rt.Kind() != reflect.Func {
+		return nil
+	}
+	numIn := rt.NumIn()
+	offset := 0
+	if takesContext(rt, len(args), varArg) {
+		// the context of the run is passed as first argument
+		offset = 1
+	}
+	if wrongNumberOfArgs(numIn-offset, rt.IsVariadic(), len(args), varArg) {
+		c.errorf(pos, "function wants %v arguments but received %v", numIn, len(args))
+		return nil
+	}
+	if !varArg {
+		for i, t := range types {
+			var in reflect.Type
+			if rt.IsVariadic() && i+offset >= numIn-1 {
+				in = rt.In(numIn - 1).Elem()
+			} else {
+				in = rt.In(i + offset)
+			}
+			if t != nil && !typeAssignable(t, in) {
+				c.errorf(args[i], "function wants argument type %v but received type %v", in, t)
+			}
+		}
+	}
+	numOut := rt.NumOut()
+	if raise && numOut > 0 && rt.Out(numOut-1) == errorType {
+		// the error is thrown
+		numOut--
+	}
+	if numOut == 1 {
+		return rt.Out(0)
+	}
+	return nil
+}
+
+// typeAssignable returns true if a value of type from can be used as type to.
+// Numbers can be used as other numbers, slices, arrays and maps when their elements can be used,
+// values of interface types when the dynamic value could be used.
+func typeAssignable(from reflect.Type, to reflect.Type) bool {
+	if from == to {
+		return true
+	}
+	if from.Kind() == reflect.Interface {
+		return to.Kind() == reflect.Interface || to.Implements(from)
+	}
+	if to.Kind() == reflect.Interface {
+		return from.Implements(to)
+	}
+	switch to.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return isSignedKind(from.Kind()) || isUnsignedKind(from.Kind())
+	case reflect.Float32, reflect.Float64:
+		return isSignedKind(from.Kind()) || isUnsignedKind(from.Kind()) || from.Kind() == reflect.Float32 || from.Kind() == reflect.Float64
+	case reflect.String, reflect.Bool:
+		return from.Kind() == to.Kind()
+	case reflect.Slice, reflect.Array:
+		return (from.Kind() == reflect.Slice || from.Kind() == reflect.Array) && typeAssignable(from.Elem(), to.Elem())
+	case reflect.Map:
+		if from == orderedMapType {
+			return true
+		}
+		return from.Kind() == reflect.Map && typeAssignable(from.Key(), to.Key()) && typeAssignable(from.Elem(), to.Elem())
+	case reflect.Func:
+		return from.Kind() == reflect.Func
+	}
+	return false
+}
+
+// annotatedValue sets runInfo.rv to rv converted to the type of a type annotation,
+// or sets runInfo.err when rv cannot be used as that type. what names the value in the error, like var a.
+func (runInfo *runInfoStruct) annotatedValue(pos ast.Pos, typeStruct *ast.TypeStruct, rv reflect.Value, what string) {
+	t := makeType(runInfo, typeStruct)
+	if runInfo.err != nil {
+		runInfo.err = newError(pos, runInfo.err)
+		runInfo.rv = nilValue
+		return
+	}
+	if t == nil {
+		runInfo.rv = rv
+		return
+	}
+	var ok bool
+	runInfo.rv, ok = annotationValue(rv, t)
+	if !ok {
+		typeName := "nil"
+		if rv.Kind() == reflect.Interface && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		if rv.IsValid() && rv.Kind() != reflect.Interface {
+			typeName = rv.Type().String()
+		}
+		runInfo.err = newStringError(pos, "cannot use type "+typeName+" as type "+t.String()+" in "+what)
+		runInfo.rv = nilValue
+	}
+}
+
+// paramValue converts runInfo.rv, the value of parameter i of funcExpr, to the type of its annotation when Options.StrictTypes is set
+func (runInfo *runInfoStruct) paramValue(funcExpr *ast.FuncExpr, i int) {
+	if !runInfo.options.StrictTypes || funcExpr.ParamTypes == nil || funcExpr.ParamTypes[i] == nil {
+		return
+	}
+	if !funcExpr.VarArg || i < len(funcExpr.Params)-1 {
+		runInfo.annotatedValue(funcExpr, funcExpr.ParamTypes[i], runInfo.rv, "argument "+funcExpr.Params[i])
+		return
+	}
+	// the variadic parameter is annotated with the type of its elements
+	typeStruct := &ast.TypeStruct{Kind: ast.TypeSlice, SubType: funcExpr.ParamTypes[i], Dimensions: 1}
+	runInfo.annotatedValue(funcExpr, typeStruct, runInfo.rv, "argument "+funcExpr.Params[i])
+}
+
+// annotatedReturnValues checks the return values of a function against its return type annotations
+func (runInfo *runInfoStruct) annotatedReturnValues(funcExpr *ast.FuncExpr, rv reflect.Value) {
+	if len(funcExpr.ReturnTypes) == 1 {
+		runInfo.annotatedValue(funcExpr, funcExpr.ReturnTypes[0], rv, "return value")
+		return
+	}
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice {
+		runInfo.err = newStringError(funcExpr, fmt.Sprintf("function wants %v return values but received %v", len(funcExpr.ReturnTypes), rv.Kind()))
+		runInfo.rv = nilValue
+		return
+	}
+	if rv.Len() != len(funcExpr.ReturnTypes) {
+		runInfo.err = newStringError(funcExpr, fmt.Sprintf("function wants %v return values but received %v values", len(funcExpr.ReturnTypes), rv.Len()))
+		runInfo.rv = nilValue
+		return
+	}
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		runInfo.annotatedValue(funcExpr, funcExpr.ReturnTypes[i], rv.Index(i), "return value")
+		if runInfo.err != nil {
+			return
+		}
+		values[i] = interfaceOf(runInfo.rv)
+	}
+	runInfo.rv = reflect.ValueOf(values)
+}
+
+// annotationValue returns rv converted to type t and true, or false if rv cannot be used as type t
+func annotationValue(rv reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Kind() == reflect.Interface {
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t), true
+		}
+		return rv, false
+	}
+	if rv.Type() == t {
+		return rv, true
+	}
+	if t.Kind() == reflect.Interface {
+		return rv, rv.Type().Implements(t)
+	}
+	if m, ok := orderedMapOf(rv); ok && t.Kind() == reflect.Map {
+		rv = reflect.ValueOf(m.Map())
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool:
+		if !typeAssignable(rv.Type(), t) {
+			return rv, false
+		}
+		return rv.Convert(t), true
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return rv, false
+		}
+		var value reflect.Value
+		if t.Kind() == reflect.Slice {
+			value = reflect.MakeSlice(t, rv.Len(), rv.Len())
+		} else {
+			if rv.Len() != t.Len() {
+				return rv, false
+			}
+			value = reflect.New(t).Elem()
+		}
+		for i := 0; i < rv.Len(); i++ {
+			item, ok := annotationValue(rv.Index(i), t.Elem())
+			if !ok {
+				return rv, false
+			}
+			value.Index(i).Set(item)
+		}
+		return value, true
+	case reflect.Map:
+		if rv.Kind() != reflect.Map {
+			return rv, false
+		}
+		value := reflect.MakeMapWithSize(t, rv.Len())
+		for _, key := range rv.MapKeys() {
+			newKey, ok := annotationValue(key, t.Key())
+			if !ok {
+				return rv, false
+			}
+			item, ok := annotationValue(rv.MapIndex(key), t.Elem())
+			if !ok {
+				return rv, false
+			}
+			value.SetMapIndex(newKey, item)
+		}
+		return value, true
+	case reflect.Func:
+		if rv.Kind() != reflect.Func {
+			return rv, false
+		}
+		value, err := convertReflectValueToType(rv, t)
+		return value, err == nil
+	}
+	return rv, false
+}
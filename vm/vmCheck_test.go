@@ -0,0 +1,162 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+func TestTypeAnnotations(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `var a: = 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `var a: int64`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `func f(a:) {}`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `func f(): {}`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `var a: int64 = "a"; a`, RunOutput: "a"},
+		{Script: `var a: foo = 1; a`, RunOutput: int64(1)},
+		{Script: `func f(a: int64, b: string): string { return a }; f(1, 2)`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `var a: int64 = 1; a`, RunOutput: int64(1)},
+		{Script: `var a: int = 1; a`, RunOutput: int(1)},
+		{Script: `var a: float64 = 1; a`, RunOutput: float64(1)},
+		{Script: `var a: interface = 1; a`, RunOutput: int64(1)},
+		{Script: `var a: error = nil; a`, RunOutput: nil},
+		{Script: `var a: []int64 = [1, 2]; a`, RunOutput: []int64{1, 2}},
+		{Script: `var a: map[string]int64 = {"b": 1}; a`, RunOutput: map[string]int64{"b": 1}},
+		{Script: `var a: map[string]int64 = @{"b": 1}; a`, RunOutput: map[string]int64{"b": 1}},
+		{Script: `var a: []int64 = nil; a`, RunOutput: []int64(nil)},
+		{Script: `var a: time.Duration = 1; a`, Input: map[string]interface{}{"time": env.NewEnv()}, RunError: fmt.Errorf("undefined type 'Duration'")},
+		{Script: `var a, b: string = [1, "c"]; [a, b]`, RunOutput: []interface{}{int64(1), "c"}},
+		{Script: `var a, b: string = 1, "c"; [a, b]`, RunOutput: []interface{}{int64(1), "c"}},
+		{Script: `var a: int64 = "a"`, RunError: fmt.Errorf("cannot use type string as type int64 in var a")},
+		{Script: `var a: int64 = 1.5`, RunError: fmt.Errorf("cannot use type float64 as type int64 in var a")},
+		{Script: `var a: int64 = nil`, RunError: fmt.Errorf("cannot use type nil as type int64 in var a")},
+		{Script: `var a: []int64 = [1, "b"]`, RunError: fmt.Errorf("cannot use type []interface {} as type []int64 in var a")},
+		{Script: `var a: foo = 1`, RunError: fmt.Errorf("undefined type 'foo'")},
+		{Script: `var a, b: string = [1, 2]`, RunError: fmt.Errorf("cannot use type int64 as type string in var b")},
+
+		{Script: `func f(a: int64, b: string): string { return b + a }; f(1, "b")`, RunOutput: "b1"},
+		{Script: `func f(a: int, b) { return a }; f(1, "b")`, RunOutput: int(1)},
+		{Script: `func f(a: int64...) { return a }; f(1, 2)`, RunOutput: []int64{1, 2}},
+		{Script: `func f(a: int64...) { return a }; f()`, RunOutput: []int64{}},
+		{Script: `func f(): (int64, string) { return 1, "b" }; f()`, RunOutput: []interface{}{int64(1), "b"}},
+		{Script: `func f(a): error { return a }; f(nil)`, RunOutput: nil},
+		{Script: `func f(a: int64) { return a }; f("a")`, RunError: fmt.Errorf("cannot use type string as type int64 in argument a")},
+		{Script: `func f(a: int64...) { return a }; f(1, "b")`, RunError: fmt.Errorf("cannot use type []interface {} as type []int64 in argument a")},
+		{Script: `func f(): string { return 1 }; f()`, RunError: fmt.Errorf("cannot use type int64 as type string in return value")},
+		{Script: `func f(): (int64, string) { return 1, 2 }; f()`, RunError: fmt.Errorf("cannot use type int64 as type string in return value")},
+		{Script: `func f(): (int64, string) { return 1, "b", 2 }; f()`, RunError: fmt.Errorf("function wants 2 return values but received 3 values")},
+		{Script: `func f(): (int64, string) { return 1 }; f()`, RunError: fmt.Errorf("function wants 2 return values but received int64")},
+		{Script: `func f(): int64 { return 1 }; a(f)`, Input: map[string]interface{}{"a": func(f func() int64) int64 { return f() }}, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, StrictTypes: true})
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		script string
+		input  map[string]interface{}
+		types  map[string]interface{}
+		errors []string
+	}{
+		{script: `a = 1; a = "b"; func f(a, b) { return a }; f(1, 2)`},
+		{script: `var a: int64 = 1; var b: []string = ["c"]; var c: map[string]int64 = {"d": 1}; var d: float64 = a`},
+		{script: `var a: int64 = "b"`, errors: []string{"1:16 cannot use type string as type int64 in var a"}},
+		{script: `var a: int64 = 1; a = "b"`, errors: []string{"1:23 cannot use type string as type int64 in assignment to a"}},
+		{script: `var a: int64 = 1; func f() { a = "b" }`, errors: []string{"1:34 cannot use type string as type int64 in assignment to a"}},
+		{script: `var a: int64 = 1; func f() { var a = "b"; a = "c" }`},
+		{script: `var a: foo = 1`, errors: []string{"1:1 undefined type 'foo'"}},
+		{script: `const a = 1; a = 2`, errors: []string{"1:14 cannot assign to constant 'a'"}},
+		{script: `const a = 1; func f() { var a = 2; a = 3 }`},
+		{script: `const a: string = 1`, errors: []string{"1:19 cannot use type int64 as type string in const a"}},
+		{script: `var a: foo = 1`, types: map[string]interface{}{"foo": int64(1)}},
+		{script: `var a: foo = "b"`, types: map[string]interface{}{"foo": int64(1)}, errors: []string{"1:14 cannot use type string as type int64 in var a"}},
+		{script: `var a: []int64 = [1, 2.5]; var b: string = len(a)`, errors: []string{"1:44 cannot use type int64 as type string in var b"}},
+
+		{script: `func f(a: int64, b: string): string { return b }; f(1, "c"); f(1.5, 2)`,
+			errors: []string{"1:64 cannot use type float64 as type int64 in argument a", "1:69 cannot use type int64 as type string in argument b"}},
+		{script: `func f(a: int64, b) { return a }; f(1)`, errors: []string{"1:35 function wants 2 arguments but received 1"}},
+		{script: `func f(a: int64...) { }; f(1, 2, "c")`, errors: []string{"1:34 cannot use type string as type int64 in argument a"}},
+		{script: `func f(a: int64...) { var b: []int64 = a }`},
+		{script: `func f(a: bar) { }; func g(): (bar, int64) { return nil, 1 }`, errors: []string{"1:1 undefined type 'bar'", "1:21 undefined type 'bar'"}},
+		{script: `func f(): string { return 1 }`, errors: []string{"1:27 cannot use type int64 as type string in return value"}},
+		{script: `func f(): string { if true { return "a" }; return func() { return 1 }() }`},
+		{script: `func f(): (int64, string) { return 1 }`, errors: []string{"1:29 function wants 2 return values but received 1 values"}},
+		{script: `func f(): (int64, string) { return g() }`},
+		{script: `func f(): int64 { return 1 }; var a: string = f()`, errors: []string{"1:47 cannot use type int64 as type string in var a"}},
+		{script: `f = func(a: int64) { }; f("b")`, errors: []string{"1:27 cannot use type string as type int64 in argument a"}},
+		{script: `func(a: int64) { }("b")`, errors: []string{"1:20 cannot use type string as type int64 in argument a"}},
+
+		{script: `a(1, "b")`, input: map[string]interface{}{"a": func(int, string) {}}},
+		{script: `a("b", 1)`, input: map[string]interface{}{"a": func(int, string) {}},
+			errors: []string{"1:3 function wants argument type int but received type string", "1:8 function wants argument type string but received type int64"}},
+		{script: `a(1)`, input: map[string]interface{}{"a": func(int, string) {}}, errors: []string{"1:1 function wants 2 arguments but received 1"}},
+		{script: `a("b", 1, 2)`, input: map[string]interface{}{"a": func(string, ...int) {}}},
+		{script: `a("b", 1, "c")`, input: map[string]interface{}{"a": func(string, ...int) {}}, errors: []string{"1:11 function wants argument type int but received type string"}},
+		{script: `a(b...)`, input: map[string]interface{}{"a": func(string, ...int) {}}},
+		{script: `a(1)`, input: map[string]interface{}{"a": func(context.Context, int) {}}},
+		{script: `a("b")`, input: map[string]interface{}{"a": func(context.Context, int) {}}, errors: []string{"1:3 function wants argument type int but received type string"}},
+		{script: `var b: string = a()`, input: map[string]interface{}{"a": func() int { return 1 }}, errors: []string{"1:17 cannot use type int as type string in var b"}},
+		{script: `a = 1; a("b")`, input: map[string]interface{}{"a": func() int { return 1 }}},
+		{script: `var b: int = try a()`, input: map[string]interface{}{"a": func() (int, error) { return 1, nil }}},
+		{script: `var b: string = try a()`, input: map[string]interface{}{"a": func() (int, error) { return 1, nil }}, errors: []string{"1:21 cannot use type int as type string in var b"}},
+
+		{script: `strings = import("strings"); var a: string = strings.ToLower("A"); strings.Repeat(a, "b")`,
+			errors: []string{"1:86 function wants argument type int but received type string"}},
+		{script: `strings = import("strings"); strings.Nope()`, errors: []string{"1:30 undefined symbol 'Nope'"}},
+		{script: `var time = import("time"); var a: time.Duration = time.Second; var b: time.Time = a`,
+			errors: []string{"1:83 cannot use type time.Duration as type time.Time in var b"}},
+		{script: `a = import("nope")`, errors: []string{"1:5 package not found: nope"}},
+		{script: `if true { var a: int64 = 1 } else { for a in [1] { try { } catch (e: nope) { } } }`, errors: []string{"1:60 undefined type 'nope'"}},
+	}
+
+	for _, test := range tests {
+		stmt, err := parser.ParseSrc(test.script)
+		if err != nil {
+			t.Errorf("ParseSrc error - received: %v - script: %v", err, test.script)
+			continue
+		}
+		e := env.NewEnv()
+		for name, value := range test.input {
+			err = e.Define(name, value)
+			if err != nil {
+				t.Fatal("Define error:", err)
+			}
+		}
+		for name, value := range test.types {
+			err = e.DefineType(name, value)
+			if err != nil {
+				t.Fatal("DefineType error:", err)
+			}
+		}
+		errs := Check(e, stmt)
+		messages := make([]string, len(errs))
+		for i, err := range errs {
+			var pos ast.Position
+			if vmErr, ok := err.(*Error); ok {
+				pos = vmErr.Pos
+			}
+			messages[i] = fmt.Sprintf("%v:%v %v", pos.Line, pos.Column, err)
+		}
+		if len(messages) != len(test.errors) || (len(messages) > 0 && !reflect.DeepEqual(messages, test.errors)) {
+			t.Errorf("Check - received: %v - expected: %v - script: %v", strings.Join(messages, ", "), strings.Join(test.errors, ", "), test.script)
+		}
+		if symbols := e.Symbols(); len(symbols) != len(test.input) {
+			t.Errorf("Check changed env - received: %v - script: %v", symbols, test.script)
+		}
+	}
+}
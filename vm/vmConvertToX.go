@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+
+	"github.com/mattn/anko/env"
 )
 
 // reflectValueSlicetoInterfaceSlice convert from a slice of reflect.Value to a interface slice
@@ -34,6 +36,14 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 		// if reflect can covert, do that conversion and return
 		return rv.Convert(rt), nil
 	}
+	if m, ok := orderedMapOf(rv); ok && rt.Kind() == reflect.Map {
+		// convert ordered map to map
+		value, err := convertMap(reflect.ValueOf(m.Map()), rt)
+		if err != nil {
+			return rv, err
+		}
+		return value, nil
+	}
 	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) &&
 		(rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
 		// covert slice or array
@@ -64,6 +74,10 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 			return ptrV, nil
 		}
 	}
+	if rt.Kind() == reflect.Interface && (rv.Kind() == reflect.Map || rv.Kind() == reflect.Ptr) {
+		// for script maps and modules of functions, call convertToInterfaceAdapter
+		return convertToInterfaceAdapter(rv, rt)
+	}
 	if rv.Type() == interfaceType {
 		if rv.IsNil() {
 			// return nil of correct type
@@ -129,6 +143,61 @@ func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, erro
 	return value, nil
 }
 
+// convertToInterfaceAdapter makes the adapter of the interface type rt from env.InterfaceAdapters
+// with the functions of the script map or module rv as its methods
+func convertToInterfaceAdapter(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	adapterType, ok := env.InterfaceAdapters[rt]
+	if !ok || !reflect.PtrTo(adapterType).Implements(rt) {
+		return rv, errInvalidTypeConversion
+	}
+
+	adapter := reflect.New(adapterType)
+	for i := 0; i < adapterType.NumField(); i++ {
+		field := adapterType.Field(i)
+		name := field.Tag.Get("anko")
+		if name == "" {
+			name = field.Name
+		}
+		method, err := scriptMethod(rv, name)
+		if err != nil {
+			return rv, err
+		}
+		method, err = convertReflectValueToType(method, field.Type)
+		if err != nil {
+			return rv, err
+		}
+		adapter.Elem().Field(i).Set(method)
+	}
+	return adapter.Convert(rt), nil
+}
+
+// scriptMethod returns the function named name of a script map or module
+func scriptMethod(rv reflect.Value, name string) (reflect.Value, error) {
+	var method reflect.Value
+	if e, ok := rv.Interface().(*env.Env); ok {
+		var err error
+		method, err = e.GetValue(name)
+		if err != nil {
+			return rv, errInvalidTypeConversion
+		}
+	} else if m, ok := orderedMapOf(rv); ok {
+		method = orderedMapIndex(m, reflect.ValueOf(name))
+	} else if rv.Kind() == reflect.Map {
+		key, err := convertReflectValueToType(reflect.ValueOf(name), rv.Type().Key())
+		if err != nil {
+			return rv, errInvalidTypeConversion
+		}
+		method = rv.MapIndex(key)
+	}
+	if method.IsValid() && method.Kind() == reflect.Interface && !method.IsNil() {
+		method = method.Elem()
+	}
+	if !method.IsValid() || method.Kind() != reflect.Func {
+		return rv, errInvalidTypeConversion
+	}
+	return method, nil
+}
+
 // convertVMFunctionToType is for translating a runVMFunction into the correct type
 // so it can be passed to a Go function argument with the correct static types
 // it creates a translate function runVMConvertFunction
@@ -159,7 +228,7 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 
 		// call processCallReturnValues to process runVMFunction return values
 		// returns normal VM reflect.Value form
-		rv, err := processCallReturnValues(rvs, true, false)
+		rv, err := processCallReturnValues(rvs, true, false, nil)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,334 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/ast/astutil"
+)
+
+type (
+	// Coverage records how many times the statements and branches of scripts have run.
+	// Files are added with AddFile, then Options.Coverage is set to record while running.
+	// Only statements of added files are recorded.
+	Coverage struct {
+		mutex    sync.RWMutex
+		files    []*coverageFile
+		stmts    map[ast.Stmt]*coverageStmt
+		branches map[ast.Pos]*CoverageBranch
+	}
+
+	// CoverageStmt is the run count of a statement.
+	CoverageStmt struct {
+		File  string
+		Pos   ast.Position
+		End   ast.Position
+		Count int64
+	}
+
+	// CoverageBranch is the run counts of the sides of a branch point,
+	// like the then, else if, and else of an if statement.
+	CoverageBranch struct {
+		File   string
+		Pos    ast.Position
+		Kind   string
+		Names  []string
+		Counts []int64
+	}
+
+	coverageFile struct {
+		name     string
+		lines    []string
+		stmts    []*coverageStmt
+		branches []*CoverageBranch
+	}
+
+	coverageStmt struct {
+		pos   ast.Position
+		end   ast.Position
+		count int64
+	}
+)
+
+// NewCoverage creates an empty Coverage.
+func NewCoverage() *Coverage {
+	return &Coverage{
+		stmts:    make(map[ast.Stmt]*coverageStmt),
+		branches: make(map[ast.Pos]*CoverageBranch),
+	}
+}
+
+// AddFile adds the statements and branch points of a parsed file to the coverage with a count of zero.
+// The source is used for the end positions of statements and for annotated reports, it can be empty.
+func (coverage *Coverage) AddFile(filename string, source string, stmt ast.Stmt) error {
+	file := &coverageFile{name: filename}
+	if source != "" {
+		file.lines = strings.Split(source, "\n")
+	}
+
+	stmts := make(map[ast.Stmt]*coverageStmt)
+	branches := make(map[ast.Pos]*CoverageBranch)
+	addBranch := func(node ast.Pos, kind string, names []string) {
+		branch := &CoverageBranch{File: filename, Pos: node.Position(), Kind: kind, Names: names, Counts: make([]int64, len(names))}
+		file.branches = append(file.branches, branch)
+		branches[node] = branch
+	}
+
+	elseIfs := make(map[ast.Pos]struct{})
+	err := astutil.Walk(stmt, func(node interface{}) error {
+		switch node := node.(type) {
+		case *ast.StmtsStmt:
+			for _, stmt := range node.Stmts {
+				stmts[stmt] = &coverageStmt{pos: stmt.Position()}
+				file.stmts = append(file.stmts, stmts[stmt])
+			}
+		case *ast.IfStmt:
+			if _, ok := elseIfs[node]; ok {
+				return nil
+			}
+			names := []string{"then"}
+			for i, elseIf := range node.ElseIf {
+				elseIfs[elseIf] = struct{}{}
+				names = append(names, fmt.Sprintf("else if %v", i+1))
+			}
+			addBranch(node, "if", append(names, "else"))
+		case *ast.SwitchStmt:
+			names := make([]string, 0, len(node.Cases)+1)
+			for i := range node.Cases {
+				names = append(names, fmt.Sprintf("case %v", i+1))
+			}
+			addBranch(node, "switch", append(names, "default"))
+		case *ast.TernaryOpExpr:
+			addBranch(node, "ternary", []string{"true", "false"})
+		case *ast.NilCoalescingOpExpr:
+			addBranch(node, "nil coalescing", []string{"left", "right"})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(file.stmts, func(i, j int) bool { return positionLess(file.stmts[i].pos, file.stmts[j].pos) })
+	for i, stmt := range file.stmts {
+		// a statement ends at the next statement on the same line or at the end of the line
+		if i+1 < len(file.stmts) && file.stmts[i+1].pos.Line == stmt.pos.Line {
+			stmt.end = file.stmts[i+1].pos
+			continue
+		}
+		stmt.end = ast.Position{Line: stmt.pos.Line, Column: stmt.pos.Column + 1}
+		if stmt.pos.Line > 0 && stmt.pos.Line <= len(file.lines) {
+			stmt.end.Column = len([]rune(file.lines[stmt.pos.Line-1])) + 1
+		}
+	}
+
+	coverage.mutex.Lock()
+	coverage.files = append(coverage.files, file)
+	for stmt, coverageStmt := range stmts {
+		coverage.stmts[stmt] = coverageStmt
+	}
+	for node, branch := range branches {
+		coverage.branches[node] = branch
+	}
+	coverage.mutex.Unlock()
+
+	return nil
+}
+
+// countStmt adds one to the count of the statement
+func (coverage *Coverage) countStmt(stmt ast.Stmt) {
+	coverage.mutex.RLock()
+	coverageStmt, ok := coverage.stmts[stmt]
+	coverage.mutex.RUnlock()
+	if ok {
+		atomic.AddInt64(&coverageStmt.count, 1)
+	}
+}
+
+// countBranch adds one to the count of side of the branch point node.
+// A negative side is the last side, like the else of an if statement.
+func (coverage *Coverage) countBranch(node ast.Pos, side int) {
+	coverage.mutex.RLock()
+	branch, ok := coverage.branches[node]
+	coverage.mutex.RUnlock()
+	if !ok {
+		return
+	}
+	if side < 0 || side >= len(branch.Counts) {
+		side = len(branch.Counts) - 1
+	}
+	atomic.AddInt64(&branch.Counts[side], 1)
+}
+
+// Stmts returns the statements with their run counts, in file and position order.
+func (coverage *Coverage) Stmts() []CoverageStmt {
+	coverage.mutex.RLock()
+	defer coverage.mutex.RUnlock()
+	var stmts []CoverageStmt
+	for _, file := range coverage.files {
+		for _, stmt := range file.stmts {
+			stmts = append(stmts, CoverageStmt{File: file.name, Pos: stmt.pos, End: stmt.end, Count: atomic.LoadInt64(&stmt.count)})
+		}
+	}
+	return stmts
+}
+
+// Branches returns the branch points with their run counts, in file and position order.
+func (coverage *Coverage) Branches() []CoverageBranch {
+	coverage.mutex.RLock()
+	defer coverage.mutex.RUnlock()
+	var branches []CoverageBranch
+	for _, file := range coverage.files {
+		for _, branch := range file.sortedBranches() {
+			counts := make([]int64, len(branch.Counts))
+			for i := range branch.Counts {
+				counts[i] = atomic.LoadInt64(&branch.Counts[i])
+			}
+			branches = append(branches, CoverageBranch{File: branch.File, Pos: branch.Pos, Kind: branch.Kind, Names: branch.Names, Counts: counts})
+		}
+	}
+	return branches
+}
+
+// Percent returns the percent of statements that have run.
+func (coverage *Coverage) Percent() float64 {
+	stmts := coverage.Stmts()
+	if len(stmts) == 0 {
+		return 0
+	}
+	var ran int
+	for _, stmt := range stmts {
+		if stmt.Count > 0 {
+			ran++
+		}
+	}
+	return 100 * float64(ran) / float64(len(stmts))
+}
+
+// WriteProfile writes the statement counts in the Go coverprofile format so go tool cover can read it.
+func (coverage *Coverage) WriteProfile(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	fmt.Fprintln(writer, "mode: count")
+	for _, stmt := range coverage.Stmts() {
+		fmt.Fprintf(writer, "%s:%d.%d,%d.%d 1 %d\n", stmt.File, stmt.Pos.Line, stmt.Pos.Column, stmt.End.Line, stmt.End.Column, stmt.Count)
+	}
+	return writer.Flush()
+}
+
+// WriteText writes the source of the files with the run count of each line and the branch counts.
+func (coverage *Coverage) WriteText(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	coverage.writeAnnotated(func(file string) {
+		fmt.Fprintf(writer, "%s\n", file)
+	}, func(count string, line string) {
+		fmt.Fprintf(writer, "%6s | %s\n", count, line)
+	}, func(branch string) {
+		fmt.Fprintf(writer, "%6s |   %s\n", "", branch)
+	})
+	fmt.Fprintf(writer, "coverage: %.1f%% of statements\n", coverage.Percent())
+	return writer.Flush()
+}
+
+// WriteHTML writes the source of the files as HTML with lines colored by whether they have run.
+func (coverage *Coverage) WriteHTML(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	fmt.Fprint(writer, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<style>
+body { background: black; color: rgb(80, 80, 80); }
+pre { font-family: Menlo, monospace; font-weight: bold; }
+.cov0 { color: rgb(192, 0, 0); }
+.cov1 { color: rgb(44, 212, 149); }
+.branch { color: rgb(128, 128, 128); font-weight: normal; }
+</style>
+</head>
+<body>
+`)
+	coverage.writeAnnotated(func(file string) {
+		fmt.Fprintf(writer, "<h3>%s</h3>\n<pre>", html.EscapeString(file))
+	}, func(count string, line string) {
+		switch count {
+		case "":
+			fmt.Fprintf(writer, "%s\n", html.EscapeString(line))
+		case "0":
+			fmt.Fprintf(writer, "<span class=\"cov0\" title=\"0\">%s</span>\n", html.EscapeString(line))
+		default:
+			fmt.Fprintf(writer, "<span class=\"cov1\" title=\"%s\">%s</span>\n", count, html.EscapeString(line))
+		}
+	}, func(branch string) {
+		fmt.Fprintf(writer, "<span class=\"branch\">  %s</span>\n", html.EscapeString(branch))
+	})
+	fmt.Fprintf(writer, "</pre>\n<p>coverage: %.1f%% of statements</p>\n</body>\n</html>\n", coverage.Percent())
+	return writer.Flush()
+}
+
+// writeAnnotated calls the write functions for each file, line, and branch point
+func (coverage *Coverage) writeAnnotated(writeFile func(string), writeLine func(string, string), writeBranch func(string)) {
+	coverage.mutex.RLock()
+	defer coverage.mutex.RUnlock()
+	for _, file := range coverage.files {
+		writeFile(file.name)
+
+		// the count of a line is the count of the first statement on the line
+		counts := make(map[int]int64)
+		for _, stmt := range file.stmts {
+			if _, ok := counts[stmt.pos.Line]; !ok {
+				counts[stmt.pos.Line] = atomic.LoadInt64(&stmt.count)
+			}
+		}
+		branches := make(map[int][]*CoverageBranch)
+		for _, branch := range file.sortedBranches() {
+			branches[branch.Pos.Line] = append(branches[branch.Pos.Line], branch)
+		}
+
+		lines := file.lines
+		if lines == nil {
+			// no source, write one line per line with statements
+			for line := range counts {
+				for len(lines) < line {
+					lines = append(lines, "")
+				}
+			}
+		}
+		for i, line := range lines {
+			count, ok := counts[i+1]
+			if ok {
+				writeLine(fmt.Sprint(count), line)
+			} else {
+				writeLine("", line)
+			}
+			for _, branch := range branches[i+1] {
+				sides := make([]string, len(branch.Names))
+				for j := range branch.Names {
+					sides[j] = fmt.Sprintf("%s %d", branch.Names[j], atomic.LoadInt64(&branch.Counts[j]))
+				}
+				writeBranch(fmt.Sprintf("%d:%d %s: %s", branch.Pos.Line, branch.Pos.Column, branch.Kind, strings.Join(sides, ", ")))
+			}
+		}
+	}
+}
+
+// sortedBranches returns the branches of the file sorted by position
+func (file *coverageFile) sortedBranches() []*CoverageBranch {
+	branches := make([]*CoverageBranch, len(file.branches))
+	copy(branches, file.branches)
+	sort.SliceStable(branches, func(i, j int) bool { return positionLess(branches[i].Pos, branches[j].Pos) })
+	return branches
+}
+
+// positionLess returns true if position a is before position b
+func positionLess(a ast.Position, b ast.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
+}
@@ -0,0 +1,103 @@
+package vm
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+
+	script := `a = 1
+if a > 1 {
+	a = 2
+} else if a == 1 {
+	a = 3
+}
+for i = 0; i < 2; i++ {
+	switch i {
+	case 0:
+		b = i > 0 ? "a" : "b"
+	default:
+		b = nil ?? "c"
+	}
+}
+func f() { return 1 }
+a`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	coverage := NewCoverage()
+	err = coverage.AddFile("test.ank", script, stmt)
+	if err != nil {
+		t.Fatal("AddFile error:", err)
+	}
+
+	_, err = Run(env.NewEnv(), &Options{Debug: true, Coverage: coverage}, stmt)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+
+	var profile bytes.Buffer
+	err = coverage.WriteProfile(&profile)
+	if err != nil {
+		t.Fatal("WriteProfile error:", err)
+	}
+	expected := `mode: count
+test.ank:1.1,1.6 1 1
+test.ank:2.1,2.11 1 1
+test.ank:3.2,3.7 1 0
+test.ank:5.2,5.7 1 1
+test.ank:7.1,7.24 1 1
+test.ank:8.2,8.12 1 2
+test.ank:10.3,10.24 1 1
+test.ank:12.3,12.17 1 1
+test.ank:15.1,15.12 1 1
+test.ank:15.12,15.22 1 0
+test.ank:16.1,16.2 1 1
+`
+	if profile.String() != expected {
+		t.Errorf("WriteProfile - received:\n%v - expected:\n%v", profile.String(), expected)
+	}
+
+	branches := coverage.Branches()
+	counts := make(map[string][]int64)
+	for _, branch := range branches {
+		counts[branch.Kind] = branch.Counts
+	}
+	expectedCounts := map[string][]int64{
+		"if":             {0, 1, 0},
+		"switch":         {1, 1},
+		"ternary":        {0, 1},
+		"nil coalescing": {0, 1},
+	}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("Branches - received: %v - expected: %v", counts, expectedCounts)
+	}
+
+	var text bytes.Buffer
+	err = coverage.WriteText(&text)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	for _, line := range []string{"     0 | \ta = 2", "       |   2:1 if: then 0, else if 1 1, else 0", "coverage: 81.8% of statements"} {
+		if !strings.Contains(text.String(), line+"\n") {
+			t.Errorf("WriteText - received:\n%v - expected line: %v", text.String(), line)
+		}
+	}
+
+	var html bytes.Buffer
+	err = coverage.WriteHTML(&html)
+	if err != nil {
+		t.Fatal("WriteHTML error:", err)
+	}
+	if !strings.Contains(html.String(), `<span class="cov0" title="0">	a = 2</span>`) {
+		t.Errorf("WriteHTML - received:\n%v", html.String())
+	}
+}
@@ -0,0 +1,171 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// deterministicKey is the context key of the clock and random numbers of a deterministic run
+	deterministicKey struct{}
+
+	// deterministic is the clock and random numbers of a deterministic run
+	deterministic struct {
+		now    func() time.Time
+		random *rand.Rand
+	}
+
+	// lockedSource is a rand.Source that goroutines of the host can use at the same time
+	lockedSource struct {
+		mutex  sync.Mutex
+		source rand.Source64
+	}
+)
+
+// deterministicTime is the time of the clock of deterministic runs that do not set Options Clock
+var deterministicTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// withDeterministic returns ctx with the clock and random numbers of Options Clock and RandSeed.
+// A deterministic run inside a deterministic run keeps the clock and random numbers of the outer run.
+func withDeterministic(ctx context.Context, options *Options) context.Context {
+	if !options.Deterministic || IsDeterministic(ctx) {
+		return ctx
+	}
+	now := options.Clock
+	if now == nil {
+		now = func() time.Time { return deterministicTime }
+	}
+	random := rand.New(&lockedSource{source: rand.NewSource(options.RandSeed).(rand.Source64)})
+	return context.WithValue(ctx, deterministicKey{}, deterministic{now: now, random: random})
+}
+
+// IsDeterministic returns true if the run of ctx is in Options Deterministic mode.
+// Use it in Go functions that get the context of a run, like VM functions, to return values in a reproducible order.
+func IsDeterministic(ctx context.Context) bool {
+	_, ok := ctx.Value(deterministicKey{}).(deterministic)
+	return ok
+}
+
+// deterministicFromContext returns the clock and random numbers of the run of ctx and true if the run is deterministic
+func deterministicFromContext(ctx context.Context) (deterministic, bool) {
+	runDeterministic, ok := ctx.Value(deterministicKey{}).(deterministic)
+	return runDeterministic, ok
+}
+
+// Int63 returns a non-negative pseudo-random 63-bit integer
+func (source *lockedSource) Int63() int64 {
+	source.mutex.Lock()
+	n := source.source.Int63()
+	source.mutex.Unlock()
+	return n
+}
+
+// Uint64 returns a pseudo-random 64-bit integer
+func (source *lockedSource) Uint64() uint64 {
+	source.mutex.Lock()
+	n := source.source.Uint64()
+	source.mutex.Unlock()
+	return n
+}
+
+// Seed seeds the source
+func (source *lockedSource) Seed(seed int64) {
+	source.mutex.Lock()
+	source.source.Seed(seed)
+	source.mutex.Unlock()
+}
+
+// SortValues sorts values, like map keys, in the order of deterministic runs:
+// nil first, then booleans, numbers, strings and other values, each in ascending order.
+// Other values are ordered by their type and fmt format.
+func SortValues(values []reflect.Value) {
+	sort.SliceStable(values, func(i int, j int) bool {
+		return lessValue(values[i], values[j])
+	})
+}
+
+// lessValue returns true if a comes before b in the order of SortValues
+func lessValue(a reflect.Value, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+	aOrder, bOrder := sortOrder(a), sortOrder(b)
+	if aOrder != bOrder {
+		return aOrder < bOrder
+	}
+
+	switch aOrder {
+	case 0:
+		return false
+	case 1:
+		return !a.Bool() && b.Bool()
+	case 2:
+		switch {
+		case isFloatKind(a.Kind()) || isFloatKind(b.Kind()):
+			if aFloat, bFloat := numberFloat64(a), numberFloat64(b); aFloat != bFloat {
+				return aFloat < bFloat
+			}
+		case isSignedKind(a.Kind()) && isSignedKind(b.Kind()):
+			if a.Int() != b.Int() {
+				return a.Int() < b.Int()
+			}
+		case isUnsignedKind(a.Kind()) && isUnsignedKind(b.Kind()):
+			if a.Uint() != b.Uint() {
+				return a.Uint() < b.Uint()
+			}
+		case isSignedKind(a.Kind()):
+			if a.Int() < 0 || uint64(a.Int()) != b.Uint() {
+				return a.Int() < 0 || uint64(a.Int()) < b.Uint()
+			}
+		default:
+			if b.Int() < 0 || a.Uint() != uint64(b.Int()) {
+				return b.Int() >= 0 && a.Uint() < uint64(b.Int())
+			}
+		}
+	case 3:
+		if a.String() != b.String() {
+			return a.String() < b.String()
+		}
+	case 4:
+		if aString, bString := fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()); aString != bString {
+			return aString < bString
+		}
+	}
+	return a.Type().String() < b.Type().String()
+}
+
+// numberFloat64 returns the value of a number as float64
+func numberFloat64(rv reflect.Value) float64 {
+	switch {
+	case isFloatKind(rv.Kind()):
+		return rv.Float()
+	case isSignedKind(rv.Kind()):
+		return float64(rv.Int())
+	}
+	return float64(rv.Uint())
+}
+
+// sortOrder returns the group of a value in the order of SortValues
+func sortOrder(rv reflect.Value) int {
+	switch {
+	case !rv.IsValid() || (rv.Kind() == reflect.Interface && rv.IsNil()):
+		return 0
+	case rv.Kind() == reflect.Bool:
+		return 1
+	case isNumberKind(rv.Kind()):
+		return 2
+	case rv.Kind() == reflect.String:
+		return 3
+	case !rv.CanInterface():
+		return 5
+	}
+	return 4
+}
@@ -0,0 +1,106 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+	_ "github.com/mattn/anko/packages"
+)
+
+func TestDeterministic(t *testing.T) {
+	t.Parallel()
+
+	clock := func() time.Time { return time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC) }
+	tests := []Test{
+		{Script: `a = {"c": 1, "b": 2, "a": 3, "d": 4}; b = []; for k in a { b += k }; b`, RunOutput: []interface{}{"a", "b", "c", "d"}},
+		{Script: `a = {3: 1, 1.5: 2, "a": 3, nil: 4, true: 5, -1: 6}; b = []; for k, v in a { b += v }; b`, RunOutput: []interface{}{int64(4), int64(5), int64(6), int64(2), int64(1), int64(3)}},
+		{Script: `b = []; for k in a { b += k }; b`, Input: map[string]interface{}{"a": map[uint8]bool{3: true, 1: true, 2: true}}, RunOutput: []interface{}{uint8(1), uint8(2), uint8(3)}},
+		{Script: `a = @{"c": 1, "a": 2}; b = []; for k in a { b += k }; b`, RunOutput: []interface{}{"c", "a"}},
+		{Script: `go func() { }()`, RunError: fmt.Errorf("go statement is not allowed in deterministic mode")},
+		{Script: `func f() { go f() }; f()`, RunError: fmt.Errorf("go statement is not allowed in deterministic mode")},
+
+		{Script: `time = import("time"); time.Now()`, RunOutput: clock()},
+		{Script: `time = import("time"); time.Since(time.Now().Add(-time.Second))`, RunOutput: time.Second},
+		{Script: `rand = import("math/rand"); [rand.Intn(1000000), rand.Intn(1000000), rand.Float64() < 1]`, RunOutput: []interface{}{int(randIntn(1, 1000000, 0)), int(randIntn(1, 1000000, 1)), true}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Deterministic: true, Clock: clock, RandSeed: 1})
+
+	tests = []Test{
+		{Script: `time = import("time"); time.Now()`, RunOutput: deterministicTime},
+		{Script: `rand = import("math/rand"); rand.Intn(1000000)`, RunOutput: int(randIntn(0, 1000000, 0))},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Deterministic: true})
+
+	tests = []Test{
+		{Script: `go func() { }()`},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestDeterministicRuns(t *testing.T) {
+	t.Parallel()
+
+	script := `rand = import("math/rand"); [rand.Int63(), rand.Int63()]`
+	options := &Options{Deterministic: true, RandSeed: 5}
+	value1, err := Execute(env.NewEnv(), options, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	value2, err := Execute(env.NewEnv(), options, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if !reflect.DeepEqual(value1, value2) {
+		t.Errorf("Execute - received: %v - expected: %v", value2, value1)
+	}
+
+	// a run inside a deterministic run continues its random numbers
+	e := env.NewEnv()
+	err = e.Define("run", func(ctx context.Context) (reflect.Value, reflect.Value) {
+		value, err := ExecuteContext(ctx, env.NewEnv(), nil, `rand = import("math/rand"); rand.Int63()`)
+		return reflect.ValueOf(value), reflect.ValueOf(&err).Elem()
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	value, err := Execute(e, options, `rand = import("math/rand"); [rand.Int63(), run()]`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if !reflect.DeepEqual(value, value1) {
+		t.Errorf("Execute - received: %v - expected: %v", value, value1)
+	}
+}
+
+func TestSortValues(t *testing.T) {
+	t.Parallel()
+
+	values := []interface{}{"b", uint8(2), struct{}{}, int64(-1), "a", 1.5, nil, false, true, uint64(1 << 63), int8(2), []int{1}}
+	expected := []interface{}{nil, false, true, int64(-1), 1.5, int8(2), uint8(2), uint64(1 << 63), "a", "b", []int{1}, struct{}{}}
+	rvs := make([]reflect.Value, len(values))
+	for i := range values {
+		rvs[i] = reflect.ValueOf(&values[i]).Elem()
+	}
+	SortValues(rvs)
+	sorted := make([]interface{}, len(rvs))
+	for i := range rvs {
+		sorted[i] = rvs[i].Interface()
+	}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("SortValues - received: %v - expected: %v", sorted, expected)
+	}
+}
+
+// randIntn returns the nth Intn of a math/rand source seeded with seed
+func randIntn(seed int64, n int, nth int) int {
+	random := rand.New(rand.NewSource(seed))
+	for i := 0; i < nth; i++ {
+		random.Intn(n)
+	}
+	return random.Intn(n)
+}
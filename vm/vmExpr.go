@@ -2,6 +2,7 @@ package vm
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/mattn/anko/ast"
 	"github.com/mattn/anko/env"
@@ -63,7 +64,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				return
 			}
 
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, valueType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as slice value")
 				runInfo.rv = nilValue
@@ -76,6 +77,28 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 	// MapExpr
 	case *ast.MapExpr:
+		if expr.Ordered || (expr.TypeData == nil && runInfo.options.OrderedMaps) {
+			var i int
+			m := NewOrderedMap()
+			for i, runInfo.expr = range expr.Keys {
+				runInfo.invokeExpr()
+				if runInfo.err != nil {
+					return
+				}
+				key := interfaceOf(runInfo.rv)
+
+				runInfo.expr = expr.Values[i]
+				runInfo.invokeExpr()
+				if runInfo.err != nil {
+					return
+				}
+
+				m.Set(key, runInfo.rv.Interface())
+			}
+			runInfo.rv = reflect.ValueOf(m)
+			return
+		}
+
 		if expr.TypeData == nil {
 			var i int
 			var key reflect.Value
@@ -126,7 +149,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			key, runInfo.err = convertReflectValueToType(runInfo.rv, keyType)
+			key, runInfo.err = runInfo.convertValue(runInfo.rv, keyType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+key.Type().String()+" as type "+keyType.String()+" as map key")
 				runInfo.rv = nilValue
@@ -138,7 +161,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, valueType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as map value")
 				runInfo.rv = nilValue
@@ -240,6 +263,10 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			}
 			return
 		}
+		if m, ok := orderedMapOf(runInfo.rv); ok {
+			runInfo.rv = orderedMapIndex(m, reflect.ValueOf(expr.Name))
+			return
+		}
 
 		value := runInfo.rv.MethodByName(expr.Name)
 		if value.IsValid() {
@@ -305,6 +332,11 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			item = item.Elem()
 		}
 
+		if m, ok := orderedMapOf(item); ok {
+			runInfo.rv = orderedMapIndex(m, runInfo.rv)
+			return
+		}
+
 		switch item.Kind() {
 		case reflect.String, reflect.Slice, reflect.Array:
 			var index int
@@ -466,8 +498,14 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 		if toBool(runInfo.rv) {
 			runInfo.expr = expr.LHS
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(expr, 0)
+			}
 		} else {
 			runInfo.expr = expr.RHS
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(expr, 1)
+			}
 		}
 		runInfo.invokeExpr()
 
@@ -479,11 +517,17 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		runInfo.invokeExpr()
 		if runInfo.err == nil {
 			if !isNil(runInfo.rv) {
+				if runInfo.options.Coverage != nil {
+					runInfo.options.Coverage.countBranch(expr, 0)
+				}
 				return
 			}
 		} else {
 			runInfo.err = nil
 		}
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(expr, 1)
+		}
 		runInfo.expr = expr.RHS
 		runInfo.invokeExpr()
 
@@ -499,6 +543,11 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if m, ok := orderedMapOf(runInfo.rv); ok {
+			runInfo.rv = reflect.ValueOf(int64(m.Len()))
+			return
+		}
+
 		switch runInfo.rv.Kind() {
 		case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
 			runInfo.rv = reflect.ValueOf(int64(runInfo.rv.Len()))
@@ -522,33 +571,28 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		name := runInfo.rv.String()
 		runInfo.rv = nilValue
 
-		methods, ok := env.Packages[name]
+		pack, ok := runInfo.env.LookupPackage(name)
 		if !ok {
 			runInfo.err = newStringError(expr, "package not found: "+name)
 			return
 		}
+		var packEnv *env.Env
 		var err error
-		pack := runInfo.env.NewEnv()
-		for methodName, methodValue := range methods {
-			err = pack.DefineValue(methodName, methodValue)
-			if err != nil {
-				runInfo.err = newStringError(expr, "import DefineValue error: "+err.Error())
-				return
-			}
+		if hasStreams(runInfo.ctx) {
+			streams := streamsFromContext(runInfo.ctx)
+			packEnv, err = pack.EnvWithStreams(streams.stdout, streams.stderr, streams.stdin)
+		} else {
+			packEnv, err = pack.Env()
 		}
-
-		types, ok := env.PackageTypes[name]
-		if ok {
-			for typeName, typeValue := range types {
-				err = pack.DefineReflectType(typeName, typeValue)
-				if err != nil {
-					runInfo.err = newStringError(expr, "import DefineReflectType error: "+err.Error())
-					return
-				}
-			}
+		if runDeterministic, ok := deterministicFromContext(runInfo.ctx); ok && err == nil {
+			packEnv, err = pack.EnvWithDeterministicValues(packEnv, runDeterministic.now, runDeterministic.random)
+		}
+		if err != nil {
+			runInfo.err = newStringError(expr, "import "+err.Error())
+			return
 		}
 
-		runInfo.rv = reflect.ValueOf(pack)
+		runInfo.rv = reflect.ValueOf(packEnv)
 
 	// MakeExpr
 	case *ast.MakeExpr:
@@ -697,7 +741,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		// chan lhs <- rhs is send
 
 		runInfo.rv = nilValue
-		rhs, runInfo.err = convertReflectValueToType(rhs, lhs.Type().Elem())
+		rhs, runInfo.err = runInfo.convertValue(rhs, lhs.Type().Elem())
 		if runInfo.err != nil {
 			runInfo.err = newStringError(expr, "cannot use type "+rhs.Type().String()+" as type "+lhs.Type().Elem().String()+" to send to chan")
 			return
@@ -750,19 +794,55 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		if runInfo.rv.Kind() != reflect.Slice && runInfo.rv.Kind() != reflect.Array {
-			runInfo.err = newStringError(expr, "second argument must be slice or array; but have "+runInfo.rv.Kind().String())
-			runInfo.rv = nilValue
+		if container, ok := interfaceOf(runInfo.rv).(Container); ok {
+			runInfo.rv = reflect.ValueOf(container.Contains(interfaceOf(itemExpr)))
+			return
+		}
+		if m, ok := orderedMapOf(runInfo.rv); ok {
+			runInfo.rv = reflect.ValueOf(isHashable(itemExpr) && m.Has(interfaceOf(itemExpr)))
 			return
 		}
 
-		for i := 0; i < runInfo.rv.Len(); i++ {
-			if equal(itemExpr, runInfo.rv.Index(i)) {
-				runInfo.rv = trueValue
+		list := runInfo.rv
+		if list.Kind() == reflect.Interface && !list.IsNil() {
+			list = list.Elem()
+		}
+
+		switch list.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < list.Len(); i++ {
+				if equal(itemExpr, list.Index(i)) {
+					runInfo.rv = trueValue
+					return
+				}
+			}
+			runInfo.rv = falseValue
+
+		case reflect.Map:
+			// key exists, nil is only a key of maps with keys that can be nil
+			key, ok := exactMapKey(itemExpr, list.Type().Key())
+			if !ok || list.IsNil() || (isNil(itemExpr) && !isNil(key)) {
+				runInfo.rv = falseValue
+				return
+			}
+			runInfo.rv = reflect.ValueOf(list.MapIndex(key).IsValid())
+
+		case reflect.String:
+			// substring
+			if itemExpr.Kind() == reflect.Interface && !itemExpr.IsNil() {
+				itemExpr = itemExpr.Elem()
+			}
+			if itemExpr.Kind() != reflect.String {
+				runInfo.err = newStringError(expr, "first argument must be string when second argument is string; but have "+itemExpr.Kind().String())
+				runInfo.rv = nilValue
 				return
 			}
+			runInfo.rv = reflect.ValueOf(strings.Contains(list.String(), itemExpr.String()))
+
+		default:
+			runInfo.err = newStringError(expr, "second argument must be slice, array, map, string or container; but have "+list.Kind().String())
+			runInfo.rv = nilValue
 		}
-		runInfo.rv = falseValue
 
 	default:
 		runInfo.err = newStringError(expr, "unknown expression")
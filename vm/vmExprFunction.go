@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
 )
 
 // funcExpr creates a function that reflect Call can use.
@@ -29,16 +31,30 @@ func (runInfo *runInfoStruct) funcExpr() {
 	// for adding env into saved function
 	envFunc := runInfo.env
 
+	// name of the function used by the profiler
+	funcName := funcExpr.Name
+	if funcName == "" {
+		funcName = "anonymous:" + strconv.Itoa(funcExpr.Position().Line)
+	}
+
 	// create a function that can be used by reflect.MakeFunc
 	// this function is a translator that converts a function call into a vm run
 	// returns slice of reflect.Type with two values:
 	// return value of the function and error value of the run
 	runVMFunction := func(in []reflect.Value) []reflect.Value {
 		runInfo := runInfoStruct{ctx: in[0].Interface().(context.Context), options: runInfo.options, env: envFunc.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue}
+		if runInfo.options.Profiler != nil {
+			runInfo.options.Profiler.enter(runInfo.ctx, funcName, funcExpr.Position().Line)
+			defer runInfo.options.Profiler.exit(runInfo.ctx)
+		}
 
 		// add Params to newEnv, except last Params
 		for i := 0; i < len(funcExpr.Params)-1; i++ {
 			runInfo.rv = in[i+1].Interface().(reflect.Value)
+			runInfo.paramValue(funcExpr, i)
+			if runInfo.err != nil {
+				return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(runInfo.err))}
+			}
 			runInfo.env.DefineValue(funcExpr.Params[i], runInfo.rv)
 		}
 		// add last Params to newEnv
@@ -46,16 +62,23 @@ func (runInfo *runInfoStruct) funcExpr() {
 			if funcExpr.VarArg {
 				// function is variadic, add last Params to newEnv without convert to Interface and then reflect.Value
 				runInfo.rv = in[len(funcExpr.Params)]
-				runInfo.env.DefineValue(funcExpr.Params[len(funcExpr.Params)-1], runInfo.rv)
 			} else {
 				// function is not variadic, add last Params to newEnv
 				runInfo.rv = in[len(funcExpr.Params)].Interface().(reflect.Value)
-				runInfo.env.DefineValue(funcExpr.Params[len(funcExpr.Params)-1], runInfo.rv)
 			}
+			runInfo.paramValue(funcExpr, len(funcExpr.Params)-1)
+			if runInfo.err != nil {
+				return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(runInfo.err))}
+			}
+			runInfo.env.DefineValue(funcExpr.Params[len(funcExpr.Params)-1], runInfo.rv)
 		}
 
 		// run function statements
 		runInfo.runSingleStmt()
+		if (runInfo.err == nil || runInfo.err == ErrReturn) && runInfo.options.StrictTypes && len(funcExpr.ReturnTypes) > 0 {
+			runInfo.err = nil
+			runInfo.annotatedReturnValues(funcExpr, runInfo.rv)
+		}
 		if runInfo.err != nil && runInfo.err != ErrReturn {
 			runInfo.err = newError(funcExpr, runInfo.err)
 			// return nil value and error
@@ -74,7 +97,11 @@ func (runInfo *runInfoStruct) funcExpr() {
 
 	// if function name is not empty, define it in the env
 	if funcExpr.Name != "" {
-		runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		runInfo.err = runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		if runInfo.err != nil {
+			runInfo.err = newError(funcExpr, runInfo.err)
+			runInfo.rv = nilValue
+		}
 	}
 }
 
@@ -83,7 +110,6 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 	anonCallExpr := runInfo.expr.(*ast.AnonCallExpr)
 
 	runInfo.expr = anonCallExpr.Expr
-	runInfo.expr.SetPosition(anonCallExpr.Expr.Position())
 	runInfo.invokeExpr()
 	if runInfo.err != nil {
 		return
@@ -98,11 +124,31 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 		return
 	}
 
-	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
+	// a new CallExpr is made for every call so the shared statement tree is never modified
+	raise := anonCallExpr.Raise || runInfo.raisesErrors(anonCallExpr.Expr)
+	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go, Raise: raise}
 	runInfo.expr.SetPosition(anonCallExpr.Expr.Position())
 	runInfo.invokeExpr()
 }
 
+// raisesErrors returns true if expr is a function of an imported package with RaiseErrors, like pack.Func
+func (runInfo *runInfoStruct) raisesErrors(expr ast.Expr) bool {
+	memberExpr, ok := expr.(*ast.MemberExpr)
+	if !ok {
+		return false
+	}
+	identExpr, ok := memberExpr.Expr.(*ast.IdentExpr)
+	if !ok {
+		return false
+	}
+	value, err := runInfo.env.GetValue(identExpr.Lit)
+	if err != nil || !value.IsValid() || !value.CanInterface() {
+		return false
+	}
+	packEnv, ok := value.Interface().(*env.Env)
+	return ok && packEnv.RaisesErrors()
+}
+
 // callExpr handles *ast.CallExpr which calls a function
 func (runInfo *runInfoStruct) callExpr() {
 	// Note that if the function type looks the same as the VM function type, the returned values will probably be wrong
@@ -135,19 +181,26 @@ func (runInfo *runInfoStruct) callExpr() {
 	fType := f.Type()
 	// check if this is a runVMFunction type
 	isRunVMFunction := checkIfRunVMFunction(fType)
+	// check if the context of the run is passed as first argument
+	passContext := isRunVMFunction || takesContext(fType, len(callExpr.SubExprs), callExpr.VarArg)
 	// create/convert the args to the function
-	args, useCallSlice = runInfo.makeCallArgs(fType, isRunVMFunction, callExpr)
+	args, useCallSlice = runInfo.makeCallArgs(fType, isRunVMFunction, passContext, callExpr)
 	if runInfo.err != nil {
 		return
 	}
 
 	if !runInfo.options.Debug {
 		// captures panic
-		defer recoverFunc(runInfo)
+		defer recoverCallFunc(runInfo, callExpr)
 	}
 
 	runInfo.rv = nilValue
 
+	if callExpr.Go && passContext && runInfo.options.Profiler != nil {
+		// the new goroutine needs its own profiler stack
+		args[0] = reflect.ValueOf(runInfo.options.Profiler.goroutine(runInfo.ctx))
+	}
+
 	// useCallSlice lets us know to use CallSlice instead of Call because of the format of the args
 	if useCallSlice {
 		if callExpr.Go {
@@ -167,10 +220,14 @@ func (runInfo *runInfoStruct) callExpr() {
 	// Until then, this is a work around to set pointers back to VM variables
 	// This will probably panic for some functions and/or calls that are variadic
 	if !isRunVMFunction {
+		offset := 0
+		if passContext {
+			offset = 1
+		}
 		for i, expr := range callExpr.SubExprs {
 			if addrExpr, ok := expr.(*ast.AddrExpr); ok {
 				if identExpr, ok := addrExpr.Expr.(*ast.IdentExpr); ok {
-					runInfo.rv = args[i].Elem()
+					runInfo.rv = args[i+offset].Elem()
 					runInfo.expr = identExpr
 					runInfo.invokeLetExpr()
 				}
@@ -179,7 +236,11 @@ func (runInfo *runInfoStruct) callExpr() {
 	}
 
 	// processCallReturnValues to get/convert return values to normal rv form
-	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true)
+	var raisePos ast.Pos
+	if callExpr.Raise {
+		raisePos = callExpr
+	}
+	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true, raisePos)
 }
 
 // checkIfRunVMFunction checking the number and types of the reflect.Type.
@@ -207,20 +268,30 @@ func checkIfRunVMFunction(rt reflect.Type) bool {
 	return true
 }
 
+// takesContext returns true if rt is a Go function with a first parameter of type context.Context
+// and a call with numExprs arguments passes the parameters after it, so the context of the run is passed as first argument
+func takesContext(rt reflect.Type, numExprs int, varArg bool) bool {
+	if rt.NumIn() < 1 || rt.In(0) != contextType {
+		return false
+	}
+	return !wrongNumberOfArgs(rt.NumIn()-1, rt.IsVariadic(), numExprs, varArg)
+}
+
 // makeCallArgs creates the arguments reflect.Value slice for the four different kinds of functions.
+// passContext is true when the context of the run is the first argument, always for runVMFunction.
 // Also returns true if CallSlice should be used on the arguments, or false if Call should be used.
-func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool, callExpr *ast.CallExpr) ([]reflect.Value, bool) {
+func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool, passContext bool, callExpr *ast.CallExpr) ([]reflect.Value, bool) {
 	// number of arguments
 	numInReal := rt.NumIn()
 	numIn := numInReal
-	if isRunVMFunction {
-		// for runVMFunction the first arg is context so does not count against number of SubExprs
+	if passContext {
+		// the first arg is context so does not count against number of SubExprs
 		numIn--
 	}
 	if numIn < 1 {
 		// no arguments needed
-		if isRunVMFunction {
-			// for runVMFunction first arg is always context
+		if passContext {
+			// the first arg is the context
 			return []reflect.Value{reflect.ValueOf(runInfo.ctx)}, false
 		}
 		return []reflect.Value{}, false
@@ -229,10 +300,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	// number of expressions
 	numExprs := len(callExpr.SubExprs)
 	// checks to short circuit wrong number of arguments
-	if (!rt.IsVariadic() && !callExpr.VarArg && numIn != numExprs) ||
-		(rt.IsVariadic() && callExpr.VarArg && (numIn < numExprs || numIn > numExprs+1)) ||
-		(rt.IsVariadic() && !callExpr.VarArg && numIn > numExprs+1) ||
-		(!rt.IsVariadic() && callExpr.VarArg && numIn < numExprs) {
+	if wrongNumberOfArgs(numIn, rt.IsVariadic(), numExprs, callExpr.VarArg) {
 		runInfo.err = newStringError(callExpr, fmt.Sprintf("function wants %v arguments but received %v", numIn, numExprs))
 		runInfo.rv = nilValue
 		return nil, false
@@ -253,8 +321,8 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	} else {
 		args = make([]reflect.Value, 0, numExprs)
 	}
-	if isRunVMFunction {
-		// for runVMFunction first arg is always context
+	if passContext {
+		// the first arg is the context
 		args = append(args, reflect.ValueOf(runInfo.ctx))
 		indexInReal++
 	}
@@ -269,7 +337,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -297,7 +365,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -327,12 +395,13 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			return nil, false
 		}
 
+		slice := runInfo.rv
 		indexSlice := 0
 		for indexInReal < numInReal {
 			if isRunVMFunction {
-				args = append(args, reflect.ValueOf(runInfo.rv.Index(indexSlice)))
+				args = append(args, reflect.ValueOf(slice.Index(indexSlice)))
 			} else {
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv.Index(indexSlice), rt.In(indexInReal))
+				runInfo.rv, runInfo.err = runInfo.convertValue(slice.Index(indexSlice), rt.In(indexInReal))
 				if runInfo.err != nil {
 					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 						"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -366,7 +435,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -387,7 +456,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if runInfo.err != nil {
 				return nil, false
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, sliceType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -412,7 +481,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	if runInfo.err != nil {
 		return nil, false
 	}
-	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+	runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, sliceType)
 	if runInfo.err != nil {
 		runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 			"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -424,10 +493,27 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	return args, true
 }
 
-// processCallReturnValues get/converts the values returned from a function call into our normal reflect.Value, error
-func processCallReturnValues(rvs []reflect.Value, isRunVMFunction bool, convertToInterfaceSlice bool) (reflect.Value, error) {
+// wrongNumberOfArgs returns true if a function with numIn parameters cannot be called with numExprs arguments
+func wrongNumberOfArgs(numIn int, isVariadic bool, numExprs int, varArg bool) bool {
+	return (!isVariadic && !varArg && numIn != numExprs) ||
+		(isVariadic && varArg && (numIn < numExprs || numIn > numExprs+1)) ||
+		(isVariadic && !varArg && numIn > numExprs+1) ||
+		(!isVariadic && varArg && numIn < numExprs)
+}
+
+// processCallReturnValues get/converts the values returned from a function call into our normal reflect.Value, error.
+// If raisePos is not nil, a non-nil last error return value of a Go function is thrown at raisePos,
+// else the values before it are returned.
+func processCallReturnValues(rvs []reflect.Value, isRunVMFunction bool, convertToInterfaceSlice bool, raisePos ast.Pos) (reflect.Value, error) {
 	// check if it is not runVMFunction
 	if !isRunVMFunction {
+		if raisePos != nil && len(rvs) > 0 && rvs[len(rvs)-1].Type() == errorType {
+			if !rvs[len(rvs)-1].IsNil() {
+				return nilValue, newThrownError(raisePos, rvs[len(rvs)-1])
+			}
+			rvs = rvs[:len(rvs)-1]
+		}
+
 		// the function was a Go function, convert to our normal reflect.Value, error
 		switch len(rvs) {
 		case 0:
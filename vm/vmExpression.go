@@ -0,0 +1,145 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+// Expression is a parsed single expression that can be evaluated many times.
+// Like a Program, an Expression can be evaluated concurrently in different environments.
+type Expression struct {
+	expr ast.Expr
+}
+
+// defaultOptions are the options used when evaluating an Expression
+var defaultOptions = &Options{}
+
+// CompileExpr parses src into an Expression.
+// Returns an error if src is not exactly one expression.
+func CompileExpr(src string) (*Expression, error) {
+	stmt, err := parser.ParseSrc(src)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts, ok := stmt.(*ast.StmtsStmt)
+	if !ok || len(stmts.Stmts) < 1 {
+		return nil, newStringError(nil, "expression expected but found nothing")
+	}
+	if len(stmts.Stmts) > 1 {
+		return nil, newStringError(stmts.Stmts[1], "expression expected but found more than one statement")
+	}
+	exprStmt, ok := stmts.Stmts[0].(*ast.ExprStmt)
+	if !ok {
+		return nil, newStringError(stmts.Stmts[0], "expression expected but found "+stmtDescription(stmts.Stmts[0]))
+	}
+
+	return &Expression{expr: exprStmt.Expr}, nil
+}
+
+// stmtDescription returns a description of a statement for error messages
+func stmtDescription(stmt ast.Stmt) string {
+	switch stmt.(type) {
+	case *ast.VarStmt:
+		return "var statement"
+	case *ast.LetsStmt, *ast.LetMapItemStmt:
+		return "assignment, use == to compare values"
+	case *ast.IfStmt:
+		return "if statement, use the ternary operator ?: instead"
+	case *ast.ForStmt, *ast.CForStmt, *ast.LoopStmt:
+		return "for statement"
+	case *ast.SwitchStmt:
+		return "switch statement"
+	case *ast.TryStmt:
+		return "try statement"
+	case *ast.ReturnStmt:
+		return "return statement"
+	case *ast.ThrowStmt:
+		return "throw statement"
+	case *ast.BreakStmt:
+		return "break statement"
+	case *ast.ContinueStmt:
+		return "continue statement"
+	case *ast.ModuleStmt:
+		return "module statement"
+	case *ast.GoroutineStmt:
+		return "go statement"
+	case *ast.DeleteStmt:
+		return "delete statement"
+	case *ast.CloseStmt:
+		return "close statement"
+	case *ast.ChanStmt:
+		return "channel statement"
+	}
+	return fmt.Sprintf("statement %T", stmt)
+}
+
+// Eval evaluates the expression in the specified environment.
+// Use env.NewEnvFromMap to evaluate against a map of variables.
+func (expression *Expression) Eval(env *env.Env) (interface{}, error) {
+	rv, err := expression.eval(context.Background(), env, defaultOptions)
+	return rv.Interface(), err
+}
+
+// EvalContext evaluates the expression in the specified environment with context.
+func (expression *Expression) EvalContext(ctx context.Context, env *env.Env, options *Options) (interface{}, error) {
+	if options == nil {
+		options = defaultOptions
+	}
+	rv, err := expression.eval(ctx, env, options)
+	return rv.Interface(), err
+}
+
+// EvalBool evaluates the expression and converts the result to bool
+// with the same rules the VM uses for conditions.
+func (expression *Expression) EvalBool(env *env.Env) (bool, error) {
+	rv, err := expression.eval(context.Background(), env, defaultOptions)
+	if err != nil {
+		return false, err
+	}
+	return toBool(rv), nil
+}
+
+// EvalFloat evaluates the expression and converts the result to float64.
+func (expression *Expression) EvalFloat(env *env.Env) (float64, error) {
+	rv, err := expression.eval(context.Background(), env, defaultOptions)
+	if err != nil {
+		return 0, err
+	}
+	return toFloat64(rv), nil
+}
+
+// EvalInt evaluates the expression and converts the result to int64.
+func (expression *Expression) EvalInt(env *env.Env) (int64, error) {
+	rv, err := expression.eval(context.Background(), env, defaultOptions)
+	if err != nil {
+		return 0, err
+	}
+	return toInt64(rv), nil
+}
+
+// EvalString evaluates the expression and converts the result to string.
+func (expression *Expression) EvalString(env *env.Env) (string, error) {
+	rv, err := expression.eval(context.Background(), env, defaultOptions)
+	if err != nil {
+		return "", err
+	}
+	return toString(rv), nil
+}
+
+// eval evaluates the expression
+func (expression *Expression) eval(ctx context.Context, env *env.Env, options *Options) (reflect.Value, error) {
+	runInfo := runInfoStruct{ctx: ctx, env: env, options: options, expr: expression.expr, rv: nilValue}
+	endRun := runInfo.startRun()
+	defer endRun()
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return nilValue, runInfo.err
+	}
+	return runInfo.rv, nil
+}
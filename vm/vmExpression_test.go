@@ -0,0 +1,170 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestCompileExprErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		src string
+		err string
+		pos string
+	}{
+		{src: "", err: "expression expected but found nothing"},
+		{src: "a = 1", err: "expression expected but found assignment, use == to compare values"},
+		{src: "var a = 1", err: "expression expected but found var statement"},
+		{src: "a == 1\nb", err: "expression expected but found more than one statement", pos: "2:1"},
+		{src: "if a { b }", err: "expression expected but found if statement, use the ternary operator ?: instead"},
+		{src: "return 1", err: "expression expected but found return statement"},
+		{src: "1 +", err: "syntax error"},
+	}
+	for _, test := range tests {
+		_, err := CompileExpr(test.src)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("CompileExpr %q error - received: %v - expected: %v", test.src, err, test.err)
+			continue
+		}
+		if test.pos != "" {
+			vmErr, ok := err.(*Error)
+			if !ok {
+				t.Errorf("CompileExpr %q error type - received: %T - expected: %T", test.src, err, &Error{})
+				continue
+			}
+			pos := fmt.Sprintf("%v:%v", vmErr.Pos.Line, vmErr.Pos.Column)
+			if pos != test.pos {
+				t.Errorf("CompileExpr %q error position - received: %v - expected: %v", test.src, pos, test.pos)
+			}
+		}
+	}
+}
+
+func TestExpressionEval(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Age  int
+		Name string
+	}
+
+	expression, err := CompileExpr(`user.Age > 18 && region in allowed`)
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+
+	allowed := []string{"eu", "us"}
+	tests := []struct {
+		values   map[string]interface{}
+		expected bool
+	}{
+		{values: map[string]interface{}{"user": user{Age: 20}, "region": "eu", "allowed": allowed}, expected: true},
+		{values: map[string]interface{}{"user": user{Age: 10}, "region": "eu", "allowed": allowed}, expected: false},
+		{values: map[string]interface{}{"user": &user{Age: 30}, "region": "jp", "allowed": allowed}, expected: false},
+	}
+	for _, test := range tests {
+		value, err := expression.EvalBool(env.NewEnvFromMap(test.values))
+		if err != nil {
+			t.Errorf("EvalBool error: %v", err)
+			continue
+		}
+		if value != test.expected {
+			t.Errorf("EvalBool - received: %v - expected: %v", value, test.expected)
+		}
+	}
+
+	_, err = expression.EvalBool(env.NewEnvFromMap(map[string]interface{}{"region": "eu"}))
+	if err == nil || err.Error() != "undefined symbol 'user'" {
+		t.Errorf("EvalBool error - received: %v - expected: %v", err, "undefined symbol 'user'")
+	}
+}
+
+func TestExpressionEvalTypes(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("a", "1.5")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	expression, err := CompileExpr("a")
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+
+	value, err := expression.Eval(e)
+	if err != nil || value != "1.5" {
+		t.Errorf("Eval - received: %v, %v - expected: %v", value, err, "1.5")
+	}
+	aBool, err := expression.EvalBool(e)
+	if err != nil || aBool != true {
+		t.Errorf("EvalBool - received: %v, %v - expected: %v", aBool, err, true)
+	}
+	aFloat, err := expression.EvalFloat(e)
+	if err != nil || aFloat != 1.5 {
+		t.Errorf("EvalFloat - received: %v, %v - expected: %v", aFloat, err, 1.5)
+	}
+
+	expression, err = CompileExpr("1 + 2")
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+	aString, err := expression.EvalString(e)
+	if err != nil || aString != "3" {
+		t.Errorf("EvalString - received: %v, %v - expected: %v", aString, err, "3")
+	}
+	aInt, err := expression.EvalInt(e)
+	if err != nil || aInt != 3 {
+		t.Errorf("EvalInt - received: %v, %v - expected: %v", aInt, err, 3)
+	}
+}
+
+func TestExpressionEvalContext(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("write", func(ctx context.Context, s string) { fmt.Fprint(Stdout(ctx), s) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("a", map[string]int64{"c": 1, "b": 2, "a": 3, "e": 4, "d": 5})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	expression, err := CompileExpr(`write("a")`)
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+	var stdout bytes.Buffer
+	_, err = expression.EvalContext(context.Background(), e, &Options{Stdout: &stdout})
+	if err != nil || stdout.String() != "a" {
+		t.Errorf("EvalContext Stdout - received: %q, %v - expected: %q", stdout.String(), err, "a")
+	}
+
+	expression, err = CompileExpr(`func() { b = []; for k in a { b += k }; return b }()`)
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+	value, err := expression.EvalContext(context.Background(), e, &Options{Deterministic: true})
+	expected := []interface{}{"a", "b", "c", "d", "e"}
+	if err != nil || !reflect.DeepEqual(value, expected) {
+		t.Errorf("EvalContext Deterministic - received: %v, %v - expected: %v", value, err, expected)
+	}
+
+	expression, err = CompileExpr(`import("time").Now()`)
+	if err != nil {
+		t.Fatal("CompileExpr error:", err)
+	}
+	value, err = expression.EvalContext(context.Background(), e, &Options{Deterministic: true})
+	if err != nil || value != deterministicTime {
+		t.Errorf("EvalContext Deterministic - received: %v, %v - expected: %v", value, err, deterministicTime)
+	}
+}
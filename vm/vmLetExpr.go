@@ -12,7 +12,17 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 	// IdentExpr
 	case *ast.IdentExpr:
-		if runInfo.env.SetValue(expr.Lit, runInfo.rv) != nil {
+		if err := runInfo.env.SetValue(expr.Lit, runInfo.rv); err != nil {
+			if _, ok := err.(*env.ConstError); ok {
+				runInfo.err = newError(expr, err)
+				runInfo.rv = nilValue
+				return
+			}
+			if runInfo.options.Strict && err != env.ErrReadOnly {
+				runInfo.err = newStringError(expr, "undefined symbol '"+expr.Lit+"', use var to define it in strict mode")
+				runInfo.rv = nilValue
+				return
+			}
 			runInfo.err = nil
 			runInfo.env.DefineValue(expr.Lit, runInfo.rv)
 		}
@@ -39,6 +49,10 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			}
 			return
 		}
+		if m, ok := orderedMapOf(runInfo.rv); ok {
+			m.Set(expr.Name, interfaceOf(value))
+			return
+		}
 
 		if runInfo.rv.Kind() == reflect.Ptr {
 			runInfo.rv = runInfo.rv.Elem()
@@ -64,7 +78,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type())
+			value, runInfo.err = runInfo.convertValue(value, runInfo.rv.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for struct")
 				runInfo.rv = nilValue
@@ -76,7 +90,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type().Elem())
+			value, runInfo.err = runInfo.convertValue(value, runInfo.rv.Type().Elem())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -120,6 +134,10 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 		if item.Kind() == reflect.Interface && !item.IsNil() {
 			item = item.Elem()
 		}
+		if m, ok := orderedMapOf(item); ok {
+			m.Set(interfaceOf(runInfo.rv), interfaceOf(value))
+			return
+		}
 
 		switch item.Kind() {
 
@@ -135,7 +153,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 			if index == item.Len() {
 				// try to do automatic append
-				value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+				value, runInfo.err = runInfo.convertValue(value, item.Type().Elem())
 				if runInfo.err != nil {
 					runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for slice index")
 					runInfo.rv = nilValue
@@ -161,7 +179,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = runInfo.convertValue(value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()+" for slice index")
 				runInfo.rv = nilValue
@@ -173,14 +191,14 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = runInfo.convertValue(runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "index type "+runInfo.rv.Type().String()+" cannot be used for map index type "+item.Type().Key().String())
 				runInfo.rv = nilValue
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+			value, runInfo.err = runInfo.convertValue(value, item.Type().Elem())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -211,7 +229,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = runInfo.convertValue(value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String())
 				runInfo.rv = nilValue
@@ -266,6 +284,10 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 		if item.Kind() == reflect.Interface && !item.IsNil() {
 			item = item.Elem()
 		}
+		if m, ok := orderedMapOf(item); ok {
+			m.Set(interfaceOf(runInfo.rv), interfaceOf(value))
+			return
+		}
 
 		switch item.Kind() {
 
@@ -1,9 +1,12 @@
 package vm
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/mattn/anko/env"
 )
 
 func TestBasicOperators(t *testing.T) {
@@ -915,13 +918,50 @@ func TestItemInList(t *testing.T) {
 		{Script: `list_of_list = [["a"]];for l in list_of_list { return "a" in l }`, RunOutput: true},
 		{Script: `for l in list_of_list { return "a" in l }`, Input: map[string]interface{}{"list_of_list": []interface{}{[]interface{}{"a"}}}, RunOutput: true},
 
-		// not slice or array
-		// todo: support `"a" in "aaa"` ?
-		{Script: `"a" in "aaa"`, RunError: fmt.Errorf("second argument must be slice or array; but have string")},
-		{Script: `1 in 12345`, RunError: fmt.Errorf("second argument must be slice or array; but have int64")},
+		// not slice, array, map, string or container
+		{Script: `1 in 12345`, RunError: fmt.Errorf("second argument must be slice, array, map, string or container; but have int64")},
+		{Script: `1 in nil`, RunError: fmt.Errorf("second argument must be slice, array, map, string or container; but have interface")},
+		{Script: `1 in a`, Input: map[string]interface{}{"a": make(chan int64, 1)}, RunError: fmt.Errorf("second argument must be slice, array, map, string or container; but have chan")},
+
+		// substring
+		{Script: `"a" in "aaa"`, RunOutput: true},
+		{Script: `"ab" in "aaa"`, RunOutput: false},
+		{Script: `"" in "aaa"`, RunOutput: true},
+		{Script: `a in "aaa"`, Input: map[string]interface{}{"a": "aa"}, RunOutput: true},
+		{Script: `"a" in b`, Input: map[string]interface{}{"b": []interface{}{"aaa"}}, RunOutput: false},
+		{Script: `"a" in b[0]`, Input: map[string]interface{}{"b": []interface{}{"aaa"}}, RunOutput: true},
+		{Script: `1 in "123"`, RunError: fmt.Errorf("first argument must be string when second argument is string; but have int64")},
+
+		// map keys
+		{Script: `"a" in {"a": 1}`, RunOutput: true},
+		{Script: `"a" in {"a": nil}`, RunOutput: true},
+		{Script: `"b" in {"a": 1}`, RunOutput: false},
+		{Script: `1 in {1: "a"}`, RunOutput: true},
+		{Script: `"a" in {1: "a"}`, RunOutput: false},
+		{Script: `nil in {nil: "a"}`, RunOutput: true},
+		{Script: `nil in {"": "a"}`, RunOutput: false},
+		{Script: `"b" in a`, Input: map[string]interface{}{"a": map[string]int64{"b": 1}}, RunOutput: true},
+		{Script: `1 in a`, Input: map[string]interface{}{"a": map[string]int64{"b": 1}}, RunOutput: false},
+		{Script: `nil in a`, Input: map[string]interface{}{"a": map[string]int64{"": 1}}, RunOutput: false},
+		{Script: `1 in a`, Input: map[string]interface{}{"a": map[int32]bool{1: false}}, RunOutput: true},
+		{Script: `"b" in a`, Input: map[string]interface{}{"a": map[string]int64(nil)}, RunOutput: false},
+		{Script: `1.5 in a`, Input: map[string]interface{}{"a": map[int64]bool{1: true}}, RunOutput: false},
+		{Script: `1.0 in a`, Input: map[string]interface{}{"a": map[int64]bool{1: true}}, RunOutput: true},
+		{Script: `"1" in a`, Input: map[string]interface{}{"a": map[int64]bool{1: true}}, RunOutput: false},
+		{Script: `300 in a`, Input: map[string]interface{}{"a": map[uint8]bool{44: true}}, RunOutput: false},
+		{Script: `[1] in {"a": 1}`, RunOutput: false},
+		{Script: `[1] in @{"a": 1}`, RunOutput: false},
+		{Script: `{"a": 1} in @{"a": 1}`, RunOutput: false},
+		{Script: `[1] in a`, Input: map[string]interface{}{"a": map[interface{}]bool{1: true}}, RunOutput: false},
+		{Script: `"a" in @{"a": 1}`, RunOutput: true},
+
+		// container
+		{Script: `"b" in a`, Input: map[string]interface{}{"a": testContainer{"b": true}}, RunOutput: true},
+		{Script: `"c" in a`, Input: map[string]interface{}{"a": testContainer{"b": true, "c": false}}, RunOutput: false},
+		{Script: `1 in a`, Input: map[string]interface{}{"a": testContainer{"b": true}}, RunOutput: false},
 
 		// a in item in list
-		{Script: `"a" in 5 in [1, 2, 3]`, RunError: fmt.Errorf("second argument must be slice or array; but have bool")},
+		{Script: `"a" in 5 in [1, 2, 3]`, RunError: fmt.Errorf("second argument must be slice, array, map, string or container; but have bool")},
 
 		// applying a in b in several part of expresstion/statement
 		{Script: `switch 1 in [1] {case true: return true;default: return false}`, RunOutput: true},
@@ -993,6 +1033,14 @@ func TestItemInList(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+// testContainer is a set that contains the strings mapped to true
+type testContainer map[string]bool
+
+func (c testContainer) Contains(item interface{}) bool {
+	s, ok := item.(string)
+	return ok && c[s]
+}
+
 func TestOperatorPrecedence(t *testing.T) {
 	t.Parallel()
 
@@ -1068,6 +1116,88 @@ func TestTry(t *testing.T) {
 		// test variable scope
 		{Script: `try { 1++ } catch a { if a.Error() == "invalid operation" { return 1 } else { return 2 } }`, RunOutput: int64(1)},
 		{Script: `try { 1++ } catch a { } finally { if a.Error() == "invalid operation" { return 1 } else { return 2 } }`, RunOutput: int64(1)},
+
+		// test thrown values
+		{Script: `try { throw {"a": 1} } catch (e) { return e.Value.a }`, RunOutput: int64(1)},
+		{Script: `try { throw 1 } catch (e: int64) { return e + 1 }`, RunOutput: int64(2)},
+		{Script: `try { throw "a" } catch (e: int64) { return 1 } catch (e: string) { return e }`, RunOutput: "a"},
+		{Script: `try { throw "a" } catch (e: int64) { return 1 }`, RunError: fmt.Errorf("a")},
+		{Script: `try { throw "a" } catch (e: int64) { return 1 } finally { b = 2 }; return b`, RunError: fmt.Errorf("a")},
+		{Script: `b = 1; try { throw "a" } catch (e: int64) { return 1 } finally { b = 2 }`, RunError: fmt.Errorf("a"), Output: map[string]interface{}{"b": int64(2)}},
+		{Script: `try { 1++ } catch (e: string) { return 1 } catch { return 2 }`, RunOutput: int64(2)},
+		{Script: `try { throw 1 } catch (e: foo) { return 1 }`, RunError: fmt.Errorf("undefined type 'foo'")},
+		{Script: `try { throw 1 } catch (e: nilT) { return 1 }`, Types: map[string]interface{}{"nilT": nil}, RunError: fmt.Errorf("cannot catch type nil")},
+		{Script: `try { throw a } catch (e: NotFound) { return e.Name }`, Types: map[string]interface{}{"NotFound": &testNotFoundError{}}, Input: map[string]interface{}{"a": &testNotFoundError{Name: "b"}}, RunOutput: "b"},
+		{Script: `try { throw a } catch (e: NotFound) { return e.Name }`, Types: map[string]interface{}{"NotFound": &testNotFoundError{}}, Input: map[string]interface{}{"a": fmt.Errorf("c: %w", &testNotFoundError{Name: "b"})}, RunOutput: "b"},
+		{Script: `try { throw a } catch (e: NotFound) { return e.Name }`, Types: map[string]interface{}{"NotFound": &testNotFoundError{}}, Input: map[string]interface{}{"a": fmt.Errorf("c")}, RunError: fmt.Errorf("c")},
+
+		// test catch conditions
+		{Script: `try { throw 1 } catch (e) if e.Value == 1 { return 1 } catch { return 2 }`, RunOutput: int64(1)},
+		{Script: `try { throw 2 } catch (e) if e.Value == 1 { return 1 } catch { return 2 }`, RunOutput: int64(2)},
+		{Script: `try { throw 2 } catch (e: int64) if e > 1 { return e }`, RunOutput: int64(2)},
+		{Script: `try { throw 1 } catch (e: int64) if e > 1 { return e }`, RunError: fmt.Errorf("1")},
+		{Script: `try { throw 1 } catch (e: int64) if 1++ { return e }`, RunError: fmt.Errorf("invalid operation")},
+
+		// test rethrow
+		{Script: `try { try { throw {"a": 1} } catch (e) { throw e } } catch (e) { return e.Value.a }`, RunOutput: int64(1)},
+		{Script: `try { try { throw 1 } catch (e: string) { return 1 } } catch (e: int64) { return e }`, RunOutput: int64(1)},
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+type testNotFoundError struct {
+	Name string
+}
+
+func (err *testNotFoundError) Error() string {
+	return err.Name + " not found"
+}
+
+func TestThrownValue(t *testing.T) {
+	t.Parallel()
+
+	notFound := &testNotFoundError{Name: "a"}
+	tests := []struct {
+		script string
+		value  interface{}
+		ok     bool
+	}{
+		{script: `throw 1`, value: int64(1), ok: true},
+		{script: `throw nil`, value: nil, ok: true},
+		{script: `throw [1, "a"]`, value: []interface{}{int64(1), "a"}, ok: true},
+		{script: `throw notFound`, value: notFound, ok: true},
+		{script: `try { throw notFound } catch (e) { throw e }`, value: notFound, ok: true},
+		{script: `1++`},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		err := e.Define("notFound", notFound)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		_, err = Execute(e, nil, test.script)
+		value, ok := ThrownValue(err)
+		if ok != test.ok || !reflect.DeepEqual(value, test.value) {
+			t.Errorf("ThrownValue %v - received: %v, %v - expected: %v, %v", test.script, value, ok, test.value, test.ok)
+		}
+	}
+
+	_, err := Execute(env.NewEnv(), nil, `throw a`)
+	if _, ok := ThrownValue(err); ok {
+		t.Errorf("ThrownValue - received: %v - expected: %v", ok, false)
+	}
+
+	e := env.NewEnv()
+	err = e.Define("notFound", fmt.Errorf("b: %w", notFound))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, `throw notFound`)
+	var target *testNotFoundError
+	if !errors.As(err, &target) || target != notFound {
+		t.Errorf("errors.As - received: %v - expected: %v", target, notFound)
+	}
+	if !errors.Is(err, notFound) {
+		t.Errorf("errors.Is - received: %v - expected: %v", false, true)
+	}
+}
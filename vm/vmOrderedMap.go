@@ -0,0 +1,141 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// OrderedMap is a map that keeps the insertion order of its keys.
+// Scripts make them with @{} literals, or with all map literals when Options.OrderedMaps is set,
+// and use them like other maps. Setting an existing key keeps its position.
+// When passed to Go functions that want a map, it is converted to that map type.
+type OrderedMap struct {
+	keys   []interface{}
+	values map[interface{}]interface{}
+}
+
+var orderedMapType = reflect.TypeOf(&OrderedMap{})
+
+// NewOrderedMap returns a new empty OrderedMap
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{values: make(map[interface{}]interface{})}
+}
+
+// Len returns the number of keys
+func (m *OrderedMap) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.keys)
+}
+
+// Get returns the value of key and if the key exists
+func (m *OrderedMap) Get(key interface{}) (interface{}, bool) {
+	if m == nil {
+		return nil, false
+	}
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Has returns true if the key exists
+func (m *OrderedMap) Has(key interface{}) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set sets the value of key, a new key is added after the others
+func (m *OrderedMap) Set(key interface{}, value interface{}) {
+	if m.values == nil {
+		m.values = make(map[interface{}]interface{})
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete removes key
+func (m *OrderedMap) Delete(key interface{}) {
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	for i := range m.keys {
+		if m.keys[i] == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Keys returns the keys in insertion order
+func (m *OrderedMap) Keys() []interface{} {
+	if m == nil {
+		return []interface{}{}
+	}
+	keys := make([]interface{}, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// Values returns the values in insertion order of their keys
+func (m *OrderedMap) Values() []interface{} {
+	values := make([]interface{}, m.Len())
+	for i := range values {
+		values[i] = m.values[m.keys[i]]
+	}
+	return values
+}
+
+// Map returns the keys and values as a Go map
+func (m *OrderedMap) Map() map[interface{}]interface{} {
+	aMap := make(map[interface{}]interface{}, m.Len())
+	for i := 0; i < m.Len(); i++ {
+		aMap[m.keys[i]] = m.values[m.keys[i]]
+	}
+	return aMap
+}
+
+// String formats the map like fmt formats maps, but in insertion order
+func (m *OrderedMap) String() string {
+	var builder strings.Builder
+	builder.WriteString("map[")
+	for i := 0; i < m.Len(); i++ {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		fmt.Fprintf(&builder, "%v:%v", m.keys[i], m.values[m.keys[i]])
+	}
+	builder.WriteByte(']')
+	return builder.String()
+}
+
+// orderedMapOf returns the OrderedMap of rv if it is one
+func orderedMapOf(rv reflect.Value) (*OrderedMap, bool) {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Type() != orderedMapType || rv.IsNil() {
+		return nil, false
+	}
+	return rv.Interface().(*OrderedMap), true
+}
+
+// orderedMapIndex returns the value of key in normal VM reflect.Value form
+func orderedMapIndex(m *OrderedMap, key reflect.Value) reflect.Value {
+	value, _ := m.Get(interfaceOf(key))
+	if value == nil {
+		return nilValue
+	}
+	return reflect.ValueOf(value)
+}
+
+// interfaceOf returns the interface value of rv, nil if it has none
+func interfaceOf(rv reflect.Value) interface{} {
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil
+	}
+	return rv.Interface()
+}
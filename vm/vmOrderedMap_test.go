@@ -0,0 +1,111 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrderedMap()
+	m.Set("c", int64(1))
+	m.Set("b", nil)
+	m.Set(int64(1), "a")
+	m.Set("c", int64(2))
+	m.Delete("d")
+
+	if m.Len() != 3 {
+		t.Errorf("Len - received: %v - expected: %v", m.Len(), 3)
+	}
+	keys := []interface{}{"c", "b", int64(1)}
+	if !reflect.DeepEqual(m.Keys(), keys) {
+		t.Errorf("Keys - received: %#v - expected: %#v", m.Keys(), keys)
+	}
+	values := []interface{}{int64(2), nil, "a"}
+	if !reflect.DeepEqual(m.Values(), values) {
+		t.Errorf("Values - received: %#v - expected: %#v", m.Values(), values)
+	}
+	value, ok := m.Get("b")
+	if value != nil || !ok {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, ok, nil, true)
+	}
+	if m.Has("a") {
+		t.Errorf("Has - received: %v - expected: %v", true, false)
+	}
+	if m.String() != "map[c:2 b:<nil> 1:a]" {
+		t.Errorf("String - received: %v - expected: %v", m.String(), "map[c:2 b:<nil> 1:a]")
+	}
+
+	m.Delete("b")
+	aMap := map[interface{}]interface{}{"c": int64(2), int64(1): "a"}
+	if !reflect.DeepEqual(m.Map(), aMap) {
+		t.Errorf("Map - received: %#v - expected: %#v", m.Map(), aMap)
+	}
+	m.Set("b", true)
+	keys = []interface{}{"c", int64(1), "b"}
+	if !reflect.DeepEqual(m.Keys(), keys) {
+		t.Errorf("Keys - received: %#v - expected: %#v", m.Keys(), keys)
+	}
+
+	var nilMap *OrderedMap
+	if nilMap.Len() != 0 || nilMap.Has("a") || len(nilMap.Keys()) != 0 || nilMap.String() != "map[]" {
+		t.Errorf("nil OrderedMap - received: %v - expected: %v", nilMap, "map[]")
+	}
+}
+
+func TestOrderedMapScripts(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `@`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `@[1]`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a = @{}; len(a)`, RunOutput: int64(0)},
+		{Script: `a = @{"c": 1, "b": 2, "a": 3}; b = []; for k in a { b += k }; b`, RunOutput: []interface{}{"c", "b", "a"}},
+		{Script: `a = @{
+	"c": 1,
+	"b": 2,
+}; b = []; for k, v in a { b += [[k, v]] }; b`, RunOutput: []interface{}{[]interface{}{"c", int64(1)}, []interface{}{"b", int64(2)}}},
+		{Script: `a = @{"c": 1, "b": 2}; a.a = 3; a["c"] = 4; b = []; for k, v in a { b += [[k, v]] }; b`, RunOutput: []interface{}{[]interface{}{"c", int64(4)}, []interface{}{"b", int64(2)}, []interface{}{"a", int64(3)}}},
+		{Script: `a = @{"c": 1, "b": 2, "a": 3}; b = []; for k in a { delete(a, "b"); b += k }; b`, RunOutput: []interface{}{"c", "a"}},
+		{Script: `a = @{"c": 1, "b": 2}; for k in a { if k == "b" { break } ; return k }`, RunOutput: "c"},
+		{Script: `a = @{"c": 1, nil: 2}; b = []; for k, v in a { b += [[k, v]] }; b`, RunOutput: []interface{}{[]interface{}{"c", int64(1)}, []interface{}{nil, int64(2)}}},
+		{Script: `a = nil; for {
+	a = @{"b": 1}
+	break
+}; a.b`, RunOutput: int64(1)},
+		{Script: `for { @{"b": 1}; break }`, RunOutput: nil},
+
+		{Script: `a = @{"b": 1}; a.b`, RunOutput: int64(1)},
+		{Script: `a = @{"b": 1}; a["b"]`, RunOutput: int64(1)},
+		{Script: `a = @{1: 1}; a[1]`, RunOutput: int64(1)},
+		{Script: `a = @{"b": 1}; a.c`, RunOutput: nil},
+		{Script: `a = @{"Len": 1}; a.Len`, RunOutput: int64(1)},
+		{Script: `a = @{"b": 1}; c, d = a["b"]; [c, d]`, RunOutput: []interface{}{int64(1), true}},
+		{Script: `a = @{"b": 1}; c, d = a["c"]; [c, d]`, RunOutput: []interface{}{nil, false}},
+		{Script: `a = @{"b": @{"c": 1}}; a.b.c = 2; a.b["d"] = 3; a.b`, RunOutput: &OrderedMap{keys: []interface{}{"c", "d"}, values: map[interface{}]interface{}{"c": int64(2), "d": int64(3)}}},
+		{Script: `a = @{"b": 1}; a.b = nil; [len(a), a.b]`, RunOutput: []interface{}{int64(1), nil}},
+
+		{Script: `a = @{"b": 1, "c": 2}; delete(a, "b"); a`, RunOutput: &OrderedMap{keys: []interface{}{"c"}, values: map[interface{}]interface{}{"c": int64(2)}}},
+		{Script: `a = @{"b": 1}; delete(a, "c"); len(a)`, RunOutput: int64(1)},
+		{Script: `a = @{"b": 1}; delete(a)`, RunError: fmt.Errorf("second argument to delete cannot be nil for map")},
+		{Script: `"b" in @{"b": 1}`, RunOutput: true},
+		{Script: `"c" in @{"b": 1}`, RunOutput: false},
+
+		{Script: `a = @{"c": 1, "b": 2}; b(a)`, Input: map[string]interface{}{"b": func(m map[string]int64) int64 { return m["b"] }}, RunOutput: int64(2)},
+		{Script: `a = @{"c": 1, "b": "b"}; b(a)`, Input: map[string]interface{}{"b": func(m map[string]int64) int64 { return m["b"] }}, RunError: fmt.Errorf("function wants argument type map[string]int64 but received type *vm.OrderedMap")},
+		{Script: `a = @{"c": 1, "b": 2}; b(a)`, Input: map[string]interface{}{"b": fmt.Sprint}, RunOutput: "map[c:1 b:2]"},
+		{Script: `b = @{"c": 1}; a.b = b; a`, Input: map[string]interface{}{"a": map[string]map[string]int64{}}, RunOutput: map[string]map[string]int64{"b": {"c": 1}}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `a = {"c": 1, "b": 2}; b = []; for k in a { b += k }; b`, RunOutput: []interface{}{"c", "b"}},
+		{Script: `a = {"b": {"c": 1}}; a.b`, RunOutput: &OrderedMap{keys: []interface{}{"c"}, values: map[interface{}]interface{}{"c": int64(1)}}},
+		{Script: `map{"b": 1}`, RunOutput: map[interface{}]interface{}{"b": int64(1)}},
+		{Script: `map[string]int64{"b": 1}`, RunOutput: map[string]int64{"b": 1}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, OrderedMaps: true})
+}
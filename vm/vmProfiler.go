@@ -0,0 +1,293 @@
+package vm
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Profiler records the time spent running each line of a script and of the VM functions it calls.
+	// Set Options.Profiler to record while running, then use WriteProfile to get a pprof profile.
+	Profiler struct {
+		mutex    sync.Mutex
+		filename string
+		start    time.Time
+		samples  map[string]*profileSample
+	}
+
+	// profileFrame is a VM function and the line running in it
+	profileFrame struct {
+		function  string
+		startLine int
+		line      int
+	}
+
+	profileSample struct {
+		frames      []profileFrame
+		count       int64
+		nanoseconds int64
+	}
+
+	// profileStack is the VM function call stack of one goroutine
+	profileStack struct {
+		frames []profileFrame
+		mark   time.Time
+	}
+
+	// profileStackKey is the context key of the profileStack
+	profileStackKey struct{}
+)
+
+// NewProfiler creates a Profiler for a script file.
+// The filename is used in the profile for all script functions.
+func NewProfiler(filename string) *Profiler {
+	return &Profiler{
+		filename: filename,
+		start:    time.Now(),
+		samples:  make(map[string]*profileSample),
+	}
+}
+
+// startRun returns a context with a new stack if the context does not already have one
+func (profiler *Profiler) startRun(ctx context.Context) (context.Context, *profileStack) {
+	if _, ok := ctx.Value(profileStackKey{}).(*profileStack); ok {
+		return ctx, nil
+	}
+	stack := &profileStack{frames: []profileFrame{{function: "main", startLine: 1, line: 1}}, mark: time.Now()}
+	return context.WithValue(ctx, profileStackKey{}, stack), stack
+}
+
+// endRun records the time since the last mark of a stack made by startRun
+func (profiler *Profiler) endRun(stack *profileStack) {
+	if stack != nil {
+		profiler.record(stack)
+	}
+}
+
+// goroutine returns a context with a copy of the stack for a new goroutine
+func (profiler *Profiler) goroutine(ctx context.Context) context.Context {
+	stack, ok := ctx.Value(profileStackKey{}).(*profileStack)
+	if !ok {
+		return ctx
+	}
+	frames := make([]profileFrame, len(stack.frames))
+	copy(frames, stack.frames)
+	return context.WithValue(ctx, profileStackKey{}, &profileStack{frames: frames, mark: time.Now()})
+}
+
+// line records the time of the current line and starts timing a new line
+func (profiler *Profiler) line(ctx context.Context, line int) {
+	stack, ok := ctx.Value(profileStackKey{}).(*profileStack)
+	if !ok {
+		return
+	}
+	profiler.record(stack)
+	stack.frames[len(stack.frames)-1].line = line
+}
+
+// enter records the time of the current line and starts timing a called VM function
+func (profiler *Profiler) enter(ctx context.Context, function string, startLine int) {
+	stack, ok := ctx.Value(profileStackKey{}).(*profileStack)
+	if !ok {
+		return
+	}
+	profiler.record(stack)
+	stack.frames = append(stack.frames, profileFrame{function: function, startLine: startLine, line: startLine})
+}
+
+// exit records the time of the current line and returns to the calling VM function
+func (profiler *Profiler) exit(ctx context.Context) {
+	stack, ok := ctx.Value(profileStackKey{}).(*profileStack)
+	if !ok || len(stack.frames) < 2 {
+		return
+	}
+	profiler.record(stack)
+	stack.frames = stack.frames[:len(stack.frames)-1]
+}
+
+// record adds the time since the last mark to the sample of the current stack
+func (profiler *Profiler) record(stack *profileStack) {
+	now := time.Now()
+	elapsed := now.Sub(stack.mark)
+	stack.mark = now
+
+	var key strings.Builder
+	for _, frame := range stack.frames {
+		key.WriteString(frame.function)
+		key.WriteByte(':')
+		key.WriteString(strconv.Itoa(frame.startLine))
+		key.WriteByte(':')
+		key.WriteString(strconv.Itoa(frame.line))
+		key.WriteByte('\n')
+	}
+
+	profiler.mutex.Lock()
+	sample, ok := profiler.samples[key.String()]
+	if !ok {
+		frames := make([]profileFrame, len(stack.frames))
+		copy(frames, stack.frames)
+		sample = &profileSample{frames: frames}
+		profiler.samples[key.String()] = sample
+	}
+	sample.count++
+	sample.nanoseconds += int64(elapsed)
+	profiler.mutex.Unlock()
+}
+
+// WriteProfile writes the recorded times in the gzip compressed pprof protocol buffer format
+// so that go tool pprof can show the script functions and lines.
+func (profiler *Profiler) WriteProfile(w io.Writer) error {
+	profiler.mutex.Lock()
+	keys := make([]string, 0, len(profiler.samples))
+	for key := range profiler.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	samples := make([]profileSample, len(keys))
+	for i, key := range keys {
+		samples[i] = *profiler.samples[key]
+	}
+	profiler.mutex.Unlock()
+
+	var profile protoBuffer
+	stringIndexes := map[string]int64{"": 0}
+	stringTable := []string{""}
+	stringIndex := func(s string) int64 {
+		index, ok := stringIndexes[s]
+		if !ok {
+			index = int64(len(stringTable))
+			stringIndexes[s] = index
+			stringTable = append(stringTable, s)
+		}
+		return index
+	}
+
+	// sample types
+	for _, valueType := range [][2]string{{"samples", "count"}, {"cpu", "nanoseconds"}} {
+		var message protoBuffer
+		message.int64(1, stringIndex(valueType[0]))
+		message.int64(2, stringIndex(valueType[1]))
+		profile.message(1, message)
+	}
+
+	functions := make(map[profileFrame]uint64)
+	locations := make(map[profileFrame]uint64)
+	var functionBuffer, locationBuffer protoBuffer
+	for _, sample := range samples {
+		locationIDs := make([]uint64, len(sample.frames))
+		for i, frame := range sample.frames {
+			function := profileFrame{function: frame.function, startLine: frame.startLine}
+			functionID, ok := functions[function]
+			if !ok {
+				functionID = uint64(len(functions) + 1)
+				functions[function] = functionID
+				var message protoBuffer
+				message.uint64(1, functionID)
+				message.int64(2, stringIndex(frame.function))
+				message.int64(3, stringIndex(frame.function))
+				message.int64(4, stringIndex(profiler.filename))
+				message.int64(5, int64(frame.startLine))
+				functionBuffer.message(5, message)
+			}
+
+			locationID, ok := locations[frame]
+			if !ok {
+				locationID = uint64(len(locations) + 1)
+				locations[frame] = locationID
+				var line protoBuffer
+				line.uint64(1, functionID)
+				line.int64(2, int64(frame.line))
+				var message protoBuffer
+				message.uint64(1, locationID)
+				message.message(4, line)
+				locationBuffer.message(4, message)
+			}
+
+			// pprof stacks start with the leaf
+			locationIDs[len(sample.frames)-1-i] = locationID
+		}
+
+		var message protoBuffer
+		message.packedUint64(1, locationIDs)
+		message.packedUint64(2, []uint64{uint64(sample.count), uint64(sample.nanoseconds)})
+		profile.message(2, message)
+	}
+	profile = append(profile, locationBuffer...)
+	profile = append(profile, functionBuffer...)
+
+	var periodType protoBuffer
+	periodType.int64(1, stringIndex("cpu"))
+	periodType.int64(2, stringIndex("nanoseconds"))
+
+	for _, s := range stringTable {
+		profile.string(6, s)
+	}
+	profile.int64(9, profiler.start.UnixNano())
+	profile.int64(10, int64(time.Since(profiler.start)))
+	profile.message(11, periodType)
+	profile.int64(12, 1)
+
+	writer := bufio.NewWriter(w)
+	gzipWriter := gzip.NewWriter(writer)
+	_, err := gzipWriter.Write(profile)
+	if err != nil {
+		return err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// protoBuffer is a minimal protocol buffer encoder for the pprof profile format
+type protoBuffer []byte
+
+func (buffer *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		*buffer = append(*buffer, byte(x)|0x80)
+		x >>= 7
+	}
+	*buffer = append(*buffer, byte(x))
+}
+
+func (buffer *protoBuffer) uint64(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	buffer.varint(uint64(field) << 3)
+	buffer.varint(x)
+}
+
+func (buffer *protoBuffer) int64(field int, x int64) {
+	buffer.uint64(field, uint64(x))
+}
+
+func (buffer *protoBuffer) packedUint64(field int, xs []uint64) {
+	var packed protoBuffer
+	for _, x := range xs {
+		packed.varint(x)
+	}
+	buffer.bytes(field, packed)
+}
+
+func (buffer *protoBuffer) string(field int, s string) {
+	buffer.bytes(field, []byte(s))
+}
+
+func (buffer *protoBuffer) message(field int, message protoBuffer) {
+	buffer.bytes(field, message)
+}
+
+func (buffer *protoBuffer) bytes(field int, b []byte) {
+	buffer.varint(uint64(field)<<3 | 2)
+	buffer.varint(uint64(len(b)))
+	*buffer = append(*buffer, b...)
+}
@@ -0,0 +1,70 @@
+package vm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	script := `
+func fib(n) {
+	if n < 2 {
+		return n
+	}
+	return fib(n - 1) + fib(n - 2)
+}
+c = make(chan int64)
+go func() {
+	c <- fib(5)
+}()
+fib(10) + <-c
+`
+	profiler := NewProfiler("fib.ank")
+	value, err := Execute(env.NewEnv(), &Options{Debug: true, Profiler: profiler}, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != int64(60) {
+		t.Fatalf("Execute value - received: %v - expected: %v", value, int64(60))
+	}
+
+	stacks := make(map[string]int64)
+	for _, sample := range profiler.samples {
+		var functions []string
+		for _, frame := range sample.frames {
+			functions = append(functions, frame.function)
+		}
+		stacks[strings.Join(functions, " ")] += sample.count
+	}
+	for _, stack := range []string{"main", "main fib", "main fib fib", "main anonymous:9", "main anonymous:9 fib"} {
+		if stacks[stack] == 0 {
+			t.Errorf("no samples for stack %q in %v", stack, stacks)
+		}
+	}
+
+	var buffer bytes.Buffer
+	err = profiler.WriteProfile(&buffer)
+	if err != nil {
+		t.Fatal("WriteProfile error:", err)
+	}
+	reader, err := gzip.NewReader(&buffer)
+	if err != nil {
+		t.Fatal("NewReader error:", err)
+	}
+	profile, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal("ReadAll error:", err)
+	}
+	for _, s := range []string{"fib", "anonymous:9", "fib.ank", "nanoseconds"} {
+		if !bytes.Contains(profile, []byte(s)) {
+			t.Errorf("profile does not contain %q", s)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package vm
+
+import (
+	"context"
+	"io"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/ast/astutil"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+// Program is a parsed script that can be run many times.
+// A Program is never modified by running it, so the same Program
+// can be run concurrently in different environments.
+type Program struct {
+	stmt ast.Stmt
+}
+
+// Compile parses script into a Program.
+func Compile(script string) (*Program, error) {
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{stmt: stmt}, nil
+}
+
+// DecodeProgram reads a Program written by Program.Encode.
+func DecodeProgram(r io.Reader) (*Program, error) {
+	stmt, err := astutil.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{stmt: stmt}, nil
+}
+
+// Encode writes the compiled program to w so it can be loaded later with DecodeProgram without parsing.
+func (program *Program) Encode(w io.Writer) error {
+	return astutil.Encode(w, program.stmt)
+}
+
+// Run executes the program in the specified environment.
+func (program *Program) Run(env *env.Env, options *Options) (interface{}, error) {
+	return RunContext(context.Background(), env, options, program.stmt)
+}
+
+// RunContext executes the program in the specified environment with context.
+func (program *Program) RunContext(ctx context.Context, env *env.Env, options *Options) (interface{}, error) {
+	return RunContext(ctx, env, options, program.stmt)
+}
@@ -0,0 +1,132 @@
+package vm
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	_, err := Compile("a = ")
+	if err == nil {
+		t.Fatal("Compile error - received: nil - expected: syntax error")
+	}
+
+	program, err := Compile("a + 1")
+	if err != nil {
+		t.Fatal("Compile error:", err)
+	}
+
+	for i := int64(0); i < 3; i++ {
+		e := env.NewEnv()
+		err = e.Define("a", i)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		value, err := program.Run(e, &Options{Debug: true})
+		if err != nil {
+			t.Fatal("Run error:", err)
+		}
+		if value != i+1 {
+			t.Errorf("Run value - received: %v - expected: %v", value, i+1)
+		}
+	}
+}
+
+func TestProgramConcurrent(t *testing.T) {
+	t.Parallel()
+
+	program, err := Compile(`
+funcs = [func(x) { return x * 2 }, func(x) { return x + 1 }]
+m = {"f": func(x) { return funcs[0](x) }}
+total = 0
+for i = 0; i < 100; i++ {
+	total += m.f(i) + funcs[1](n)
+}
+total
+`)
+	if err != nil {
+		t.Fatal("Compile error:", err)
+	}
+
+	var waitGroup sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		waitGroup.Add(1)
+		go func(n int64) {
+			defer waitGroup.Done()
+			e := env.NewEnv()
+			err := e.Define("n", n)
+			if err != nil {
+				errs <- err
+				return
+			}
+			value, err := program.Run(e, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			expected := int64(9900) + 100*(n+1)
+			if value != expected {
+				errs <- fmt.Errorf("Run value - received: %v - expected: %v", value, expected)
+			}
+		}(int64(i))
+	}
+	waitGroup.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestProgramEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	program, err := Compile(`
+func fib(n) {
+	if n < 2 {
+		return n
+	}
+	return fib(n - 1) + fib(n - 2)
+}
+a = {"b": [1.5, "c", nil, true]}
+try {
+	throw "d"
+} catch e {
+	a.e = e.Error()
+}
+toString(fib(10)) + a.e + toString(len(a.b))
+`)
+	if err != nil {
+		t.Fatal("Compile error:", err)
+	}
+
+	var buffer bytes.Buffer
+	err = program.Encode(&buffer)
+	if err != nil {
+		t.Fatal("Encode error:", err)
+	}
+	program, err = DecodeProgram(&buffer)
+	if err != nil {
+		t.Fatal("DecodeProgram error:", err)
+	}
+
+	e := env.NewEnv()
+	err = e.Define("toString", func(v interface{}) string { return fmt.Sprint(v) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	value, err := program.Run(e, &Options{Debug: true})
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if value != "55d4" {
+		t.Errorf("Run value - received: %v - expected: %v", value, "55d4")
+	}
+}
@@ -0,0 +1,332 @@
+package vm
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+type (
+	// REPL runs lines of input as they are read. A statement can continue over following lines,
+	// it is run when the lines read so far parse. It is the read eval print loop of the anko command and of ServeREPL.
+	REPL struct {
+		env       *env.Env
+		options   *Options
+		source    string
+		following bool
+	}
+
+	// ServeREPLOptions are the options of ServeREPL.
+	ServeREPLOptions struct {
+		// Options are the VM options used to run statements
+		Options *Options
+		// Authenticate if not nil is called with the first line of a connection,
+		// the connection is closed if it returns false
+		Authenticate func(password string) bool
+		// Format if not nil formats the values of statements, the default is %#v
+		Format func(value interface{}) string
+		// Context if not nil stops ServeREPL when done
+		Context context.Context
+	}
+
+	// replSession is a connection to ServeREPL
+	replSession struct {
+		conn    net.Conn
+		lines   chan string
+		done    chan struct{}
+		mutex   sync.Mutex
+		cancel  context.CancelFunc
+		options *ServeREPLOptions
+	}
+)
+
+// NewREPL creates a REPL that runs statements in env.
+func NewREPL(env *env.Env, options *Options) *REPL {
+	return &REPL{env: env, options: options}
+}
+
+// Env returns the env statements are run in.
+func (repl *REPL) Env() *env.Env {
+	return repl.env
+}
+
+// SetEnv sets the env statements are run in.
+func (repl *REPL) SetEnv(env *env.Env) {
+	repl.env = env
+}
+
+// Incomplete returns true when the lines read so far are an incomplete statement.
+func (repl *REPL) Incomplete() bool {
+	return repl.following
+}
+
+// Prompt returns the prompt for the next line.
+func (repl *REPL) Prompt() string {
+	if repl.following {
+		return "  "
+	}
+	return "> "
+}
+
+// Reset discards the lines of an incomplete statement.
+func (repl *REPL) Reset() {
+	repl.source = ""
+	repl.following = false
+}
+
+// Eval adds a line to the lines read so far and runs them if they are complete statements.
+// Returns complete false when nothing was run because the line is empty or more lines are needed.
+func (repl *REPL) Eval(ctx context.Context, line string) (value interface{}, complete bool, err error) {
+	if repl.following {
+		repl.source += "\n"
+	}
+	repl.source += line
+	if repl.source == "" {
+		return nil, false, nil
+	}
+
+	stmt, err := parser.ParseSrc(repl.source)
+	if e, ok := err.(*parser.Error); ok && e.Incomplete {
+		repl.following = true
+		return nil, false, nil
+	}
+
+	repl.Reset()
+	if err != nil {
+		return nil, true, err
+	}
+
+	value, err = RunContext(ctx, repl.env, repl.options, stmt)
+	return value, true, err
+}
+
+// ServeREPL accepts connections on listener and runs a REPL for each connection, like with TCP or Unix sockets.
+// Each connection runs in a new child env of env, so values defined by a connection are not seen by others,
+// while the values of env can be used and changed by all of them.
+// Sending Ctrl-C, the ETX byte or the telnet interrupt, cancels the statement running on a connection,
+// closing the connection also cancels it. The line quit() closes the connection.
+// The stdout and stderr of statements are the connection, unless set by options.Options.
+// ServeREPL returns the error of listener Accept, or nil when options.Context is done.
+// When it returns, running statements are canceled and the connections are closed.
+func ServeREPL(listener net.Listener, env *env.Env, options *ServeREPLOptions) error {
+	if options == nil {
+		options = &ServeREPLOptions{}
+	}
+	ctx := options.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var waitGroup sync.WaitGroup
+	defer waitGroup.Wait()
+
+	var mutex sync.Mutex
+	conns := make(map[net.Conn]struct{})
+	defer func() {
+		mutex.Lock()
+		for conn := range conns {
+			conn.Close()
+		}
+		mutex.Unlock()
+	}()
+
+	// close listener to stop Accept when the context is done
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+			listener.Close()
+		case <-stopped:
+		}
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		mutex.Lock()
+		conns[conn] = struct{}{}
+		mutex.Unlock()
+
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			session := &replSession{conn: conn, lines: make(chan string, 16), done: make(chan struct{}), options: options}
+			session.run(ctx, env.NewEnv())
+			conn.Close()
+			mutex.Lock()
+			delete(conns, conn)
+			mutex.Unlock()
+		}()
+	}
+}
+
+// run runs the REPL of a connection until the connection is closed or quit() is read
+func (session *replSession) run(ctx context.Context, env *env.Env) {
+	defer close(session.done)
+	go session.readLines()
+
+	if session.options.Authenticate != nil {
+		io.WriteString(session.conn, "password: ")
+		password, ok := <-session.lines
+		if !ok || !session.options.Authenticate(password) {
+			io.WriteString(session.conn, "authentication failed\n")
+			return
+		}
+	}
+
+	// script output goes to the connection unless the options set the streams
+	var options Options
+	if session.options.Options != nil {
+		options = *session.options.Options
+	}
+	if options.Stdout == nil {
+		options.Stdout = session.conn
+	}
+	if options.Stderr == nil {
+		options.Stderr = session.conn
+	}
+
+	repl := NewREPL(env, &options)
+	for {
+		_, err := io.WriteString(session.conn, repl.Prompt())
+		if err != nil {
+			return
+		}
+
+		var line string
+		var ok bool
+		select {
+		case line, ok = <-session.lines:
+		case <-ctx.Done():
+			return
+		}
+		if !ok {
+			return
+		}
+		if !repl.Incomplete() && line == "quit()" {
+			return
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		session.mutex.Lock()
+		session.cancel = cancel
+		session.mutex.Unlock()
+
+		value, complete, err := repl.Eval(runCtx, line)
+
+		session.mutex.Lock()
+		session.cancel = nil
+		session.mutex.Unlock()
+		cancel()
+
+		switch {
+		case err != nil:
+			_, err = io.WriteString(session.conn, formatREPLError(err)+"\n")
+		case complete:
+			_, err = io.WriteString(session.conn, session.format(value)+"\n")
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// format formats a value with the Format option
+func (session *replSession) format(value interface{}) string {
+	if session.options.Format != nil {
+		return session.options.Format(value)
+	}
+	return fmt.Sprintf("%#v", value)
+}
+
+// formatREPLError formats an error with its position
+func formatREPLError(err error) string {
+	if e, ok := err.(*Error); ok {
+		return fmt.Sprintf("%d:%d %s", e.Pos.Line, e.Pos.Column, err)
+	}
+	if e, ok := err.(*parser.Error); ok {
+		return fmt.Sprintf("%d:%d %s", e.Pos.Line, e.Pos.Column, err)
+	}
+	return err.Error()
+}
+
+// readLines sends the lines of the connection to the lines channel and cancels the running statement on interrupts.
+// When the connection is closed the lines channel is closed and the running statement is canceled.
+func (session *replSession) readLines() {
+	defer func() {
+		session.cancelRunning()
+		close(session.lines)
+	}()
+
+	reader := bufio.NewReader(session.conn)
+	var line []byte
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return
+		}
+
+		switch b {
+		case 0x03:
+			// ETX, Ctrl-C
+			session.cancelRunning()
+			line = line[:0]
+			continue
+		case 0xff:
+			// telnet IAC command
+			command, err := reader.ReadByte()
+			if err != nil {
+				return
+			}
+			switch {
+			case command == 0xff:
+				line = append(line, command)
+			case command == 0xf4:
+				// interrupt process
+				session.cancelRunning()
+				line = line[:0]
+			case command >= 0xfb && command <= 0xfe:
+				// WILL, WONT, DO, DONT have an option byte
+				_, err = reader.ReadByte()
+				if err != nil {
+					return
+				}
+			}
+			continue
+		case '\n':
+			select {
+			case session.lines <- strings.TrimSuffix(string(line), "\r"):
+			case <-session.done:
+				return
+			}
+			line = line[:0]
+			continue
+		}
+		line = append(line, b)
+	}
+}
+
+// cancelRunning cancels the running statement
+func (session *replSession) cancelRunning() {
+	session.mutex.Lock()
+	if session.cancel != nil {
+		session.cancel()
+	}
+	session.mutex.Unlock()
+}
@@ -0,0 +1,217 @@
+package vm
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestREPLEval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line     string
+		value    interface{}
+		complete bool
+		err      string
+		prompt   string
+	}{
+		{line: "", prompt: "> "},
+		{line: "a = 1", value: int64(1), complete: true, prompt: "> "},
+		{line: "if a == 1 {", prompt: "  "},
+		{line: "b = 2", prompt: "  "},
+		{line: "}", value: int64(2), complete: true, prompt: "> "},
+		{line: "a + 1", value: int64(2), complete: true, prompt: "> "},
+		{line: "len([1,", prompt: "  "},
+		{line: "2])", value: int64(2), complete: true, prompt: "> "},
+		{line: "a = `b", prompt: "  "},
+		{line: "c`", value: "b\nc", complete: true, prompt: "> "},
+		{line: "var , b = 1, 2", complete: true, err: "syntax error: unexpected ','", prompt: "> "},
+		{line: "c", complete: true, err: "undefined symbol 'c'", prompt: "> "},
+	}
+
+	repl := NewREPL(env.NewEnv(), nil)
+	for _, test := range tests {
+		value, complete, err := repl.Eval(context.Background(), test.line)
+		if err != nil && test.err == "" || err == nil && test.err != "" || err != nil && err.Error() != test.err {
+			t.Errorf("Eval %q error - received: %v - expected: %v", test.line, err, test.err)
+		}
+		if value != test.value || complete != test.complete {
+			t.Errorf("Eval %q - received: %#v %v - expected: %#v %v", test.line, value, complete, test.value, test.complete)
+		}
+		if repl.Prompt() != test.prompt {
+			t.Errorf("Prompt after %q - received: %q - expected: %q", test.line, repl.Prompt(), test.prompt)
+		}
+	}
+}
+
+// replClient is a connection to ServeREPL for tests
+type replClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func dialREPL(t *testing.T, network string, address string) *replClient {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		t.Fatal("Dial error:", err)
+	}
+	return &replClient{t: t, conn: conn, reader: bufio.NewReader(conn)}
+}
+
+// expect reads the prompt then writes the line and checks the output line
+func (client *replClient) expect(prompt string, line string, expected string) {
+	client.t.Helper()
+	client.expectPrompt(prompt)
+	_, err := client.conn.Write([]byte(line + "\n"))
+	if err != nil {
+		client.t.Fatal("Write error:", err)
+	}
+	if expected == "" {
+		return
+	}
+	client.expectLine(expected)
+}
+
+// expectLine reads an output line and checks it
+func (client *replClient) expectLine(expected string) {
+	client.t.Helper()
+	output, err := client.reader.ReadString('\n')
+	if err != nil {
+		client.t.Fatal("ReadString error:", err)
+	}
+	if strings.TrimSuffix(output, "\n") != expected {
+		client.t.Fatalf("received: %q - expected: %q", output, expected)
+	}
+}
+
+func (client *replClient) expectPrompt(prompt string) {
+	client.t.Helper()
+	buffer := make([]byte, len(prompt))
+	_, err := client.reader.Read(buffer)
+	if err != nil {
+		client.t.Fatal("Read error:", err)
+	}
+	if string(buffer) != prompt {
+		client.t.Fatalf("prompt - received: %q - expected: %q", buffer, prompt)
+	}
+}
+
+func TestServeREPL(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Listen error:", err)
+	}
+	e := env.NewEnv()
+	err = e.Define("shared", "shared value")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- ServeREPL(listener, e, &ServeREPLOptions{Context: ctx})
+	}()
+
+	client1 := dialREPL(t, "tcp", listener.Addr().String())
+	client2 := dialREPL(t, "tcp", listener.Addr().String())
+
+	client1.expect("> ", "a = 1", "1")
+	client1.expect("> ", "if a == 1 {", "")
+	client1.expect("  ", "a = 2", "")
+	client1.expect("  ", "}", "2")
+	client1.expect("> ", "shared", `"shared value"`)
+	client2.expect("> ", "a", "1:1 undefined symbol 'a'")
+	client2.expect("> ", "shared = 3", "3")
+	client1.expect("> ", "shared", "3")
+	client1.expect("> ", `fmt = import("fmt"); true`, "true")
+	client1.expect("> ", `fmt.Println("output")`, "output")
+	client1.expectLine("[]interface {}{7, interface {}(nil)}")
+
+	// cancel a running statement with Ctrl-C
+	client1.expect("> ", "for { }", "")
+	time.Sleep(10 * time.Millisecond)
+	_, err = client1.conn.Write([]byte{0x03})
+	if err != nil {
+		t.Fatal("Write error:", err)
+	}
+	output, err := client1.reader.ReadString('\n')
+	if err != nil || output != "execution interrupted\n" {
+		t.Fatalf("interrupt - received: %q, %v - expected: %q", output, err, "execution interrupted\n")
+	}
+	client1.expect("> ", "a", "2")
+
+	client1.expect("> ", "quit()", "")
+	_, err = client1.reader.ReadByte()
+	if err == nil {
+		t.Fatal("quit - connection not closed")
+	}
+
+	cancel()
+	select {
+	case err = <-served:
+		if err != nil {
+			t.Fatal("ServeREPL error:", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ServeREPL did not return")
+	}
+	client2.expectPrompt("> ")
+	_, err = client2.reader.ReadByte()
+	if err == nil {
+		t.Fatal("ServeREPL returned - connection not closed")
+	}
+}
+
+func TestServeREPLAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(tempDir)
+	address := filepath.Join(tempDir, "repl.sock")
+
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		t.Fatal("Listen error:", err)
+	}
+	served := make(chan error, 1)
+	go func() {
+		served <- ServeREPL(listener, env.NewEnv(), &ServeREPLOptions{
+			Authenticate: func(password string) bool { return password == "secret" },
+			Format:       func(value interface{}) string { return fmt.Sprintf("value: %v", value) },
+		})
+	}()
+
+	client := dialREPL(t, "unix", address)
+	client.expect("password: ", "wrong", "authentication failed")
+	_, err = client.reader.ReadByte()
+	if err == nil {
+		t.Fatal("authentication failed - connection not closed")
+	}
+
+	client = dialREPL(t, "unix", address)
+	client.expect("password: ", "secret", "")
+	client.expect("> ", "1 + 1", "value: 2")
+	client.conn.Close()
+
+	listener.Close()
+	err = <-served
+	if err == nil {
+		t.Fatal("ServeREPL error - received: nil - expected: listener closed error")
+	}
+}
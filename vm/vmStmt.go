@@ -2,7 +2,7 @@ package vm
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"reflect"
 
 	"github.com/mattn/anko/ast"
@@ -41,6 +41,13 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	if runInfo.options == nil {
 		runInfo.options = &Options{}
 	}
+	if !runInfo.options.Strict && hasStrictPragma(stmt) {
+		options := *runInfo.options
+		options.Strict = true
+		runInfo.options = &options
+	}
+	endRun := runInfo.startRun()
+	defer endRun()
 	runInfo.runSingleStmt()
 	if runInfo.err == ErrReturn {
 		runInfo.err = nil
@@ -48,6 +55,25 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	return runInfo.rv.Interface(), runInfo.err
 }
 
+// startRun sets the context of a run with the streams, the deterministic mode and the profiler of the options.
+// The returned func ends the run.
+func (runInfo *runInfoStruct) startRun() func() {
+	if !runInfo.options.Deterministic && IsDeterministic(runInfo.ctx) {
+		// a run inside a deterministic run is deterministic too
+		options := *runInfo.options
+		options.Deterministic = true
+		runInfo.options = &options
+	}
+	runInfo.ctx = withStreams(runInfo.ctx, runInfo.options)
+	runInfo.ctx = withDeterministic(runInfo.ctx, runInfo.options)
+	if runInfo.options.Profiler == nil {
+		return func() {}
+	}
+	var stack *profileStack
+	runInfo.ctx, stack = runInfo.options.Profiler.startRun(runInfo.ctx)
+	return func() { runInfo.options.Profiler.endRun(stack) }
+}
+
 // runSingleStmt executes statement in the specified environment with context.
 func (runInfo *runInfoStruct) runSingleStmt() {
 	select {
@@ -66,6 +92,12 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	// StmtsStmt
 	case *ast.StmtsStmt:
 		for _, stmt := range stmt.Stmts {
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countStmt(stmt)
+			}
+			if runInfo.options.Profiler != nil {
+				runInfo.options.Profiler.line(runInfo.ctx, stmt.Position().Line)
+			}
 			switch stmt.(type) {
 			case *ast.BreakStmt:
 				runInfo.err = ErrBreak
@@ -121,7 +153,10 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
 				// value is slice/array, add each value to left side names
 				for i := 0; i < value.Len() && i < len(stmt.Names); i++ {
-					runInfo.env.DefineValue(stmt.Names[i], value.Index(i))
+					runInfo.defineVar(stmt, i, value.Index(i))
+					if runInfo.err != nil {
+						return
+					}
 				}
 				// return last value of slice/array
 				runInfo.rv = value.Index(value.Len() - 1)
@@ -131,7 +166,10 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		// define all names with right side values
 		for i = 0; i < len(rvs) && i < len(stmt.Names); i++ {
-			runInfo.env.DefineValue(stmt.Names[i], rvs[i])
+			runInfo.defineVar(stmt, i, rvs[i])
+			if runInfo.err != nil {
+				return
+			}
 		}
 
 		// return last right side value
@@ -232,6 +270,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		if toBool(runInfo.rv) {
 			// then
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(stmt, 0)
+			}
 			runInfo.rv = nilValue
 			runInfo.stmt = stmt.Then
 			runInfo.env = env.NewEnv()
@@ -240,7 +281,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
-		for _, statement := range stmt.ElseIf {
+		for i, statement := range stmt.ElseIf {
 			elseIf := statement.(*ast.IfStmt)
 
 			// else if - if
@@ -257,6 +298,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 
 			// else if - then
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(stmt, i+1)
+			}
 			runInfo.rv = nilValue
 			runInfo.stmt = elseIf.Then
 			runInfo.env = env.NewEnv()
@@ -265,6 +309,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(stmt, -1)
+		}
 		if stmt.Else != nil {
 			// else
 			runInfo.rv = nilValue
@@ -286,22 +333,34 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		runInfo.stmt = stmt.Try
 		runInfo.runSingleStmt()
 
+		var uncaught error
 		if runInfo.err != nil {
 			if runInfo.err == ErrInterrupt {
 				runInfo.env = env
 				return
 			}
 
-			// Catch
-			runInfo.stmt = stmt.Catch
-			if stmt.Var != "" {
-				runInfo.env.DefineValue(stmt.Var, reflect.ValueOf(runInfo.err))
-			}
-			runInfo.err = nil
-			runInfo.runSingleStmt()
-			if runInfo.err != nil {
-				runInfo.env = env
-				return
+			// Catch, the first clause that matches
+			uncaught = runInfo.err
+			for _, catchStmt := range stmt.Catches {
+				catchStmt := catchStmt.(*ast.CatchStmt)
+				runInfo.err = nil
+				if !runInfo.catchMatches(catchStmt, uncaught) {
+					if runInfo.err != nil {
+						runInfo.rv = nilValue
+						runInfo.env = env
+						return
+					}
+					continue
+				}
+				uncaught = nil
+				runInfo.stmt = catchStmt.Stmt
+				runInfo.runSingleStmt()
+				if runInfo.err != nil {
+					runInfo.env = env
+					return
+				}
+				break
 			}
 		}
 
@@ -312,6 +371,10 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 
 		runInfo.env = env
+		if uncaught != nil && runInfo.err == nil {
+			runInfo.err = uncaught
+			runInfo.rv = nilValue
+		}
 
 	// LoopStmt
 	case *ast.LoopStmt:
@@ -375,6 +438,54 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		env := runInfo.env
 		runInfo.env = env.NewEnv()
 
+		if m, ok := orderedMapOf(value); ok {
+			// keys are in insertion order, keys deleted in the loop are skipped
+			keys := m.Keys()
+			for i := 0; i < len(keys); i++ {
+				select {
+				case <-runInfo.ctx.Done():
+					runInfo.err = ErrInterrupt
+					runInfo.rv = nilValue
+					runInfo.env = env
+					return
+				default:
+				}
+
+				if !m.Has(keys[i]) {
+					continue
+				}
+				key := nilValue
+				if keys[i] != nil {
+					key = reflect.ValueOf(keys[i])
+				}
+				runInfo.env.DefineValue(stmt.Vars[0], key)
+
+				if len(stmt.Vars) > 1 {
+					runInfo.env.DefineValue(stmt.Vars[1], orderedMapIndex(m, key))
+				}
+
+				runInfo.stmt = stmt.Stmt
+				runInfo.runSingleStmt()
+				if runInfo.err != nil {
+					if runInfo.err == ErrContinue {
+						runInfo.err = nil
+						continue
+					}
+					if runInfo.err == ErrReturn {
+						runInfo.env = env
+						return
+					}
+					if runInfo.err == ErrBreak {
+						runInfo.err = nil
+					}
+					break
+				}
+			}
+			runInfo.rv = nilValue
+			runInfo.env = env
+			return
+		}
+
 		switch value.Kind() {
 		case reflect.Slice, reflect.Array:
 			for i := 0; i < value.Len(); i++ {
@@ -418,6 +529,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		case reflect.Map:
 			keys := value.MapKeys()
+			if runInfo.options.Deterministic {
+				SortValues(keys)
+			}
 			for i := 0; i < len(keys); i++ {
 				select {
 				case <-runInfo.ctx.Done():
@@ -601,13 +715,15 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		if runInfo.err != nil {
 			return
 		}
-		runInfo.err = newStringError(stmt, fmt.Sprint(runInfo.rv.Interface()))
+		runInfo.err = newThrownError(stmt, runInfo.rv)
 
 	// ModuleStmt
 	case *ast.ModuleStmt:
 		e := runInfo.env
 		runInfo.env, runInfo.err = e.NewModule(stmt.Name)
 		if runInfo.err != nil {
+			runInfo.env = e
+			runInfo.err = newError(stmt, runInfo.err)
 			return
 		}
 		runInfo.stmt = stmt.Stmt
@@ -631,7 +747,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 		value := runInfo.rv
 
-		for _, switchCaseStmt := range stmt.Cases {
+		for i, switchCaseStmt := range stmt.Cases {
 			caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
 			for _, runInfo.expr = range caseStmt.Exprs {
 				runInfo.invokeExpr()
@@ -640,6 +756,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					return
 				}
 				if equal(runInfo.rv, value) {
+					if runInfo.options.Coverage != nil {
+						runInfo.options.Coverage.countBranch(stmt, i)
+					}
 					runInfo.stmt = caseStmt.Stmt
 					runInfo.runSingleStmt()
 					runInfo.env = env
@@ -648,6 +767,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 		}
 
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(stmt, -1)
+		}
 		if stmt.Default == nil {
 			runInfo.rv = nilValue
 		} else {
@@ -659,6 +781,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 	// GoroutineStmt
 	case *ast.GoroutineStmt:
+		if runInfo.options.Deterministic {
+			runInfo.err = newStringError(stmt, "go statement is not allowed in deterministic mode")
+			runInfo.rv = nilValue
+			return
+		}
 		runInfo.expr = stmt.Expr
 		runInfo.invokeExpr()
 
@@ -682,15 +809,27 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		if item.Kind() == reflect.Interface && !item.IsNil() {
 			item = item.Elem()
 		}
+		if m, ok := orderedMapOf(item); ok {
+			if stmt.Key == nil {
+				runInfo.err = newStringError(stmt, "second argument to delete cannot be nil for map")
+				runInfo.rv = nilValue
+				return
+			}
+			m.Delete(interfaceOf(runInfo.rv))
+			runInfo.rv = nilValue
+			return
+		}
 
 		switch item.Kind() {
 		case reflect.String:
 			if stmt.Key != nil && runInfo.rv.Kind() == reflect.Bool && runInfo.rv.Bool() {
-				runInfo.env.DeleteGlobal(item.String())
-				runInfo.rv = nilValue
-				return
+				runInfo.err = runInfo.env.DeleteGlobalErr(item.String())
+			} else {
+				runInfo.err = runInfo.env.DeleteErr(item.String())
+			}
+			if runInfo.err != nil {
+				runInfo.err = newError(stmt, runInfo.err)
 			}
-			runInfo.env.Delete(item.String())
 			runInfo.rv = nilValue
 
 		case reflect.Map:
@@ -801,3 +940,84 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	}
 
 }
+
+// catchMatches returns true if the catch clause catches err, after defining its variable.
+// In a clause with a type the variable is the thrown value, else it is err.
+func (runInfo *runInfoStruct) catchMatches(catchStmt *ast.CatchStmt, err error) bool {
+	value := reflect.ValueOf(err)
+	if catchStmt.TypeData != nil {
+		t := makeType(runInfo, catchStmt.TypeData)
+		if runInfo.err != nil {
+			runInfo.err = newError(catchStmt, runInfo.err)
+			return false
+		}
+		if t == nil {
+			runInfo.err = newStringError(catchStmt, "cannot catch type nil")
+			return false
+		}
+		var ok bool
+		value, ok = thrownValueOfType(err, t)
+		if !ok {
+			return false
+		}
+	}
+
+	if catchStmt.Var != "" {
+		runInfo.env.DefineValue(catchStmt.Var, value)
+	}
+	if catchStmt.Cond == nil {
+		return true
+	}
+	runInfo.expr = catchStmt.Cond
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return false
+	}
+	return toBool(runInfo.rv)
+}
+
+// thrownValueOfType returns the thrown value of err, or err if nothing was thrown, when it is of type t.
+// Errors wrapped by it are found like errors.As does.
+func thrownValueOfType(err error, t reflect.Type) (reflect.Value, bool) {
+	var value interface{} = err
+	if vmErr, ok := err.(*Error); ok && vmErr.thrown {
+		value = vmErr.Value
+	}
+	if value != nil {
+		rv := reflect.ValueOf(value)
+		if rv.Type() == t || (t.Kind() == reflect.Interface && rv.Type().Implements(t)) {
+			return rv, true
+		}
+	}
+	if valueErr, ok := value.(error); ok && (t.Kind() == reflect.Interface || t.Implements(errorType)) {
+		target := reflect.New(t)
+		if errors.As(valueErr, target.Interface()) {
+			return target.Elem(), true
+		}
+	}
+	return nilValue, false
+}
+
+// defineVar defines name i of a var or const statement with the value rv, converted to its type annotation when Options.StrictTypes is set
+func (runInfo *runInfoStruct) defineVar(stmt *ast.VarStmt, i int, rv reflect.Value) {
+	runInfo.rv = rv
+	if runInfo.options.StrictTypes && stmt.Types != nil && stmt.Types[i] != nil {
+		what := "var "
+		if stmt.Const {
+			what = "const "
+		}
+		runInfo.annotatedValue(stmt, stmt.Types[i], rv, what+stmt.Names[i])
+		if runInfo.err != nil {
+			return
+		}
+	}
+	if stmt.Const {
+		runInfo.err = runInfo.env.DefineConstValue(stmt.Names[i], runInfo.rv)
+	} else {
+		runInfo.err = runInfo.env.DefineValue(stmt.Names[i], runInfo.rv)
+	}
+	if runInfo.err != nil {
+		runInfo.err = newError(stmt, runInfo.err)
+		runInfo.rv = nilValue
+	}
+}
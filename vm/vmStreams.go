@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+type (
+	// streamsKey is the context key of the streams of a run
+	streamsKey struct{}
+
+	// streams are the stdout, stderr and stdin of a run
+	streams struct {
+		stdout io.Writer
+		stderr io.Writer
+		stdin  io.Reader
+	}
+)
+
+// withStreams returns ctx with the streams set in options.
+// The streams options does not set are kept from ctx, so a run inside a run keeps the streams of the outer run.
+func withStreams(ctx context.Context, options *Options) context.Context {
+	if options.Stdout == nil && options.Stderr == nil && options.Stdin == nil {
+		return ctx
+	}
+	streams := streamsFromContext(ctx)
+	if options.Stdout != nil {
+		streams.stdout = options.Stdout
+	}
+	if options.Stderr != nil {
+		streams.stderr = options.Stderr
+	}
+	if options.Stdin != nil {
+		streams.stdin = options.Stdin
+	}
+	return context.WithValue(ctx, streamsKey{}, streams)
+}
+
+// hasStreams returns true if the run of ctx has streams set by options
+func hasStreams(ctx context.Context) bool {
+	_, ok := ctx.Value(streamsKey{}).(streams)
+	return ok
+}
+
+// streamsFromContext returns the streams of the run of ctx, the os streams if none are set
+func streamsFromContext(ctx context.Context) streams {
+	runStreams, ok := ctx.Value(streamsKey{}).(streams)
+	if !ok {
+		return streams{stdout: os.Stdout, stderr: os.Stderr, stdin: os.Stdin}
+	}
+	return runStreams
+}
+
+// Stdout returns the stdout of the run of ctx, which is Options Stdout or os.Stdout.
+// Use it in Go functions that get the context of a run, like VM functions, to write script output.
+func Stdout(ctx context.Context) io.Writer {
+	return streamsFromContext(ctx).stdout
+}
+
+// Stderr returns the stderr of the run of ctx, which is Options Stderr or os.Stderr.
+func Stderr(ctx context.Context) io.Writer {
+	return streamsFromContext(ctx).stderr
+}
+
+// Stdin returns the stdin of the run of ctx, which is Options Stdin or os.Stdin.
+func Stdin(ctx context.Context) io.Reader {
+	return streamsFromContext(ctx).stdin
+}
@@ -0,0 +1,92 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+)
+
+// hasStrictPragma returns true if the first statement of stmt is the string "use strict"
+func hasStrictPragma(stmt ast.Stmt) bool {
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok {
+		if len(stmts.Stmts) < 1 {
+			return false
+		}
+		stmt = stmts.Stmts[0]
+	}
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	literalExpr, ok := exprStmt.Expr.(*ast.LiteralExpr)
+	return ok && literalExpr.Literal.Kind() == reflect.String && literalExpr.Literal.String() == "use strict"
+}
+
+// convertValue is convertReflectValueToType that rejects lossy conversions of numbers in strict mode
+func (runInfo *runInfoStruct) convertValue(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	value, err := convertReflectValueToType(rv, rt)
+	if err != nil || !runInfo.options.Strict {
+		return value, err
+	}
+	err = checkLossyConversion(rv, value)
+	if err != nil {
+		return rv, err
+	}
+	return value, nil
+}
+
+// checkLossyConversion returns an error if value, the conversion of rv, lost information of a number,
+// like 1.5 to int, 256 to uint8 and -1 to uint, or if a number was converted to a string.
+// The items of slices and arrays are checked too.
+func checkLossyConversion(rv reflect.Value, value reflect.Value) error {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !rv.IsValid() || !value.IsValid() || rv.Type() == value.Type() || !rv.CanInterface() {
+		return nil
+	}
+
+	switch {
+	case isNumberKind(rv.Kind()) && value.Kind() == reflect.String:
+		return fmt.Errorf("cannot convert type %v to type string in strict mode", rv.Type())
+	case env.IsLossyConversion(rv, value):
+		return fmt.Errorf("cannot convert %v of type %v to type %v without loss in strict mode", rv, rv.Type(), value.Type())
+	case (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && (value.Kind() == reflect.Slice || value.Kind() == reflect.Array):
+		for i := 0; i < rv.Len() && i < value.Len(); i++ {
+			err := checkLossyConversion(rv.Index(i), value.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return isSignedKind(kind) || isUnsignedKind(kind) || isFloatKind(kind)
+}
+
+func isSignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUnsignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
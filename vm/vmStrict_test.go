@@ -0,0 +1,81 @@
+package vm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `a = 1`, RunError: fmt.Errorf("undefined symbol 'a', use var to define it in strict mode")},
+		{Script: `var total = 1; totla = total + 1`, RunError: fmt.Errorf("undefined symbol 'totla', use var to define it in strict mode")},
+		{Script: `a, b = 1, 2`, RunError: fmt.Errorf("undefined symbol 'a', use var to define it in strict mode")},
+		{Script: `a = 1`, Input: map[string]interface{}{"a": nil}, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `var a = 1; a = 2; a`, RunOutput: int64(2)},
+		{Script: `var a = 1; func f() { a = 2 }; f(); a`, RunOutput: int64(2)},
+		{Script: `func f() { var a = 1; a++; return a }; f()`, RunOutput: int64(2)},
+		{Script: `func f() { a = 1 }; f()`, RunError: fmt.Errorf("undefined symbol 'a', use var to define it in strict mode")},
+		{Script: `var a = 0; for i in [1, 2] { a += i }; a`, RunOutput: int64(3)},
+
+		{Script: `a(1)`, Input: map[string]interface{}{"a": func(b int) int { return b }}, RunOutput: int(1)},
+		{Script: `a(2.0)`, Input: map[string]interface{}{"a": func(b int) int { return b }}, RunOutput: int(2)},
+		{Script: `a(1.5)`, Input: map[string]interface{}{"a": func(b int) int { return b }}, RunError: fmt.Errorf("function wants argument type int but received type float64")},
+		{Script: `a(255)`, Input: map[string]interface{}{"a": func(b uint8) uint8 { return b }}, RunOutput: uint8(255)},
+		{Script: `a(256)`, Input: map[string]interface{}{"a": func(b uint8) uint8 { return b }}, RunError: fmt.Errorf("function wants argument type uint8 but received type int64")},
+		{Script: `a(-1)`, Input: map[string]interface{}{"a": func(b uint) uint { return b }}, RunError: fmt.Errorf("function wants argument type uint but received type int64")},
+		{Script: `a(1.5)`, Input: map[string]interface{}{"a": func(b float32) float32 { return b }}, RunOutput: float32(1.5)},
+		{Script: `a(65)`, Input: map[string]interface{}{"a": func(b string) string { return b }}, RunError: fmt.Errorf("function wants argument type string but received type int64")},
+		{Script: `a([1, 2.5])`, Input: map[string]interface{}{"a": func(b []int) int { return len(b) }}, RunError: fmt.Errorf("function wants argument type []int but received type []interface {}")},
+		{Script: `a(1, 2.5)`, Input: map[string]interface{}{"a": func(b ...int) int { return len(b) }}, RunError: fmt.Errorf("function wants argument type []int but received type float64")},
+
+		{Script: `a = make([]int64, 1); a[0] = 2.0; a`, Input: map[string]interface{}{"a": nil}, RunOutput: []int64{2}},
+		{Script: `a = make([]int64, 1); a[0] = 1.5`, Input: map[string]interface{}{"a": nil}, RunError: fmt.Errorf("type float64 cannot be assigned to type int64 for slice index")},
+		{Script: `[]uint32{1, -1}`, RunError: fmt.Errorf("cannot use type int64 as type uint32 as slice value")},
+		{Script: `a.A = 1.5`, Input: map[string]interface{}{"a": &struct{ A int }{}}, RunError: fmt.Errorf("type float64 cannot be assigned to type int for struct")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Strict: true})
+
+	tests = []Test{
+		{Script: `a = 1`, RunOutput: int64(1)},
+		{Script: `a(1.5)`, Input: map[string]interface{}{"a": func(b int) int { return b }}, RunOutput: int(1)},
+		{Script: `a(65)`, Input: map[string]interface{}{"a": func(b string) string { return b }}, RunOutput: "A"},
+		{Script: `"use strict"`, RunOutput: "use strict"},
+		{Script: `"use strict"; a = 1`, RunError: fmt.Errorf("undefined symbol 'a', use var to define it in strict mode")},
+		{Script: `"use strict"
+var a = 1
+a = 2.5`, RunOutput: float64(2.5)},
+		{Script: `"use strict"; b(1.5)`, Input: map[string]interface{}{"b": func(b int) int { return b }}, RunError: fmt.Errorf("function wants argument type int but received type float64")},
+		{Script: `a = 1; "use strict"; b = 2`, RunOutput: int64(2)},
+		{Script: `"use lax"; a = 1`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestStrictPragma(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	options := &Options{}
+	_, err := Execute(e, options, `"use strict"; var f = func() { a = 1 }`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if options.Strict {
+		t.Errorf("Strict - received: %v - expected: %v", options.Strict, false)
+	}
+
+	// functions of a strict script stay strict when called from a script that is not
+	_, err = Execute(e, options, `f()`)
+	expected := "undefined symbol 'a', use var to define it in strict mode"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Execute error - received: %v - expected: %v", err, expected)
+	}
+	_, err = Execute(e, options, `b = 1`)
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -400,6 +401,60 @@ a  =  1;
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestConst(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `const a`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `const 1 = 2`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `const a = 1; a`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a, b = 1, 2; a + b`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `const a, b = [1, 2]; b`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `const a = 1; a = 2`, RunError: fmt.Errorf("cannot assign to constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a += 2`, RunError: fmt.Errorf("cannot assign to constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a++`, RunError: fmt.Errorf("cannot assign to constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; b, a = 2, 3`, RunError: fmt.Errorf("cannot assign to constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; var a = 2`, RunError: fmt.Errorf("cannot redefine constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; const a = 2`, RunError: fmt.Errorf("cannot redefine constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func a() { }`, RunError: fmt.Errorf("cannot redefine constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; delete("a")`, RunError: fmt.Errorf("cannot delete constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { delete("a", true) }; b()`, RunError: fmt.Errorf("cannot delete constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { a = 2 }; b()`, RunError: fmt.Errorf("cannot assign to constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { var a = 2; a = 3; return a }; b()`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = [1]; a[0] = 2; a`, RunOutput: []interface{}{int64(2)}},
+		{Script: `const a: string = 1; a`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `const a: int64 = 1; a`, RunOutput: int64(1)},
+		{Script: `const a: int64 = "b"`, RunError: fmt.Errorf("cannot use type string as type int64 in const a")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, StrictTypes: true})
+
+	envSetupFunc := func(t *testing.T, e *env.Env) {
+		err := e.DefineConst("a", int64(1))
+		if err != nil {
+			t.Fatal("DefineConst error:", err)
+		}
+		err = e.Define("b", int64(2))
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		e.Freeze()
+	}
+	tests = []Test{
+		{Script: `a + b`, RunOutput: int64(3)},
+		{Script: `a = 2`, RunError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `b = 3`, RunError: fmt.Errorf("cannot assign to constant 'b'")},
+		{Script: `var b = 3`, RunError: fmt.Errorf("cannot redefine constant 'b'")},
+		{Script: `delete("b")`, RunError: fmt.Errorf("cannot delete constant 'b'")},
+		{Script: `c = 3; c`, RunOutput: int64(3)},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+}
+
 func TestModule(t *testing.T) {
 	t.Parallel()
 
@@ -453,6 +508,52 @@ func TestModule(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+type moduleService struct {
+	Foo
+	Name   string
+	Count  int64  `anko:"count"`
+	Hidden string `anko:"-"`
+}
+
+func (s *moduleService) Hello(greeting string) string {
+	return greeting + " " + s.Name
+}
+
+func TestModuleFromValue(t *testing.T) {
+	t.Parallel()
+
+	envSetupFunc := func(t *testing.T, e *env.Env) {
+		_, err := e.DefineModuleFromValue("svc", &moduleService{Foo: Foo{Value: 1}, Name: "a", Count: 2, Hidden: "b"})
+		if err != nil {
+			t.Fatal("DefineModuleFromValue error:", err)
+		}
+		_, err = e.DefineModuleFromValueWithOptions("hello", &moduleService{Name: "b"},
+			&env.ModuleOptions{Interface: reflect.TypeOf((*interface{ Hello(string) string })(nil)).Elem()})
+		if err != nil {
+			t.Fatal("DefineModuleFromValueWithOptions error:", err)
+		}
+	}
+
+	tests := []Test{
+		{Script: `svc.Hello("hi")`, RunOutput: "hi a"},
+		{Script: `svc.ValueReceiver()`, RunOutput: 1},
+		{Script: `svc.Name`, RunOutput: "a"},
+		{Script: `svc.Value`, RunOutput: 1},
+		{Script: `svc.count`, RunOutput: int64(2)},
+		{Script: `svc.Name = "b"; svc.Hello("hi")`, RunOutput: "hi b"},
+		{Script: `svc.count += 3; svc.count`, RunOutput: int64(5)},
+		{Script: `svc.Value = 2.0; svc.ValueReceiver()`, RunOutput: 2},
+		{Script: `svc.Name = 1`, RunError: fmt.Errorf("cannot use type int64 as type string to set field Name")},
+		{Script: `svc.Count`, RunError: fmt.Errorf("undefined symbol 'Count'")},
+		{Script: `svc.Hidden`, RunError: fmt.Errorf("undefined symbol 'Hidden'")},
+
+		{Script: `hello.Hello("hi")`, RunOutput: "hi b"},
+		{Script: `hello.Name`, RunError: fmt.Errorf("undefined symbol 'Name'")},
+		{Script: `hello.ValueReceiver()`, RunError: fmt.Errorf("undefined symbol 'ValueReceiver'")},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 
@@ -1145,6 +1246,60 @@ func TestContextFunction(t *testing.T) {
 	cancel()
 }
 
+func TestContextArgument(t *testing.T) {
+	t.Parallel()
+
+	hasDeadline := func(ctx context.Context) bool {
+		_, ok := ctx.Deadline()
+		return ok
+	}
+	add := func(ctx context.Context, a int64, b int64) int64 {
+		if !hasDeadline(ctx) {
+			return 0
+		}
+		return a + b
+	}
+	sum := func(ctx context.Context, a ...int64) int64 {
+		var n int64
+		for _, i := range a {
+			n += i
+		}
+		return n
+	}
+	set := func(ctx context.Context, a *int64) {
+		*a = 2
+	}
+	tests := []Test{
+		{Script: `a()`, Input: map[string]interface{}{"a": hasDeadline}, RunOutput: true},
+		{Script: `a(1, 2)`, Input: map[string]interface{}{"a": add}, RunOutput: int64(3)},
+		{Script: `a([1, 2]...)`, Input: map[string]interface{}{"a": add}, RunOutput: int64(3)},
+		{Script: `a(b, 1, 2)`, Input: map[string]interface{}{"a": add, "b": context.Background()}, RunOutput: int64(0)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": add}, RunError: fmt.Errorf("function wants 3 arguments but received 1")},
+		{Script: `a(1, "b")`, Input: map[string]interface{}{"a": add}, RunError: fmt.Errorf("function wants argument type int64 but received type string")},
+		{Script: `a()`, Input: map[string]interface{}{"a": sum}, RunOutput: int64(0)},
+		{Script: `a(1, 2, 3)`, Input: map[string]interface{}{"a": sum}, RunOutput: int64(6)},
+		{Script: `a([1, 2]...)`, Input: map[string]interface{}{"a": sum}, RunOutput: int64(3)},
+		{Script: `b = 1; a(&b); b`, Input: map[string]interface{}{"a": set}, RunOutput: int64(2)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// a host call that waits for the context is cancelled with the run
+	e := env.NewEnv()
+	err := e.Define("wait", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	value, err := ExecuteContext(ctx, e, nil, `wait()`)
+	if err != nil || value != context.DeadlineExceeded {
+		t.Errorf("ExecuteContext - received: %v, %v - expected: %v, %v", value, err, context.DeadlineExceeded, nil)
+	}
+}
+
 func TestAssignToInterface(t *testing.T) {
 	t.Parallel()
 
@@ -1378,3 +1533,185 @@ func TestLetsStatementPosition(t *testing.T) {
 		t.Fatalf("%v != %v", is, want)
 	}
 }
+
+func TestErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		script string
+		err    string
+		pos    string
+	}{
+		{script: "func f() {\n\ta = [1]\n\treturn a[2]\n}\nf()", err: "index out of range", pos: "3:9"},
+		{script: "func f() {\n\tfail()\n}\nf()", err: "vm recover a", pos: "2:2"},
+		{script: "a = 1\nb = a.c", err: "type int64 does not support member operation", pos: "2:5"},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		err := e.Define("fail", func() { panic("a") })
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		_, err = Execute(e, nil, test.script)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("execute %q error - received: %v - expected: %v", test.script, err, test.err)
+			continue
+		}
+		vmErr, ok := err.(*Error)
+		if !ok {
+			t.Errorf("execute %q error type - received: %T - expected: %T", test.script, err, &Error{})
+			continue
+		}
+		pos := fmt.Sprintf("%v:%v", vmErr.Pos.Line, vmErr.Pos.Column)
+		if pos != test.pos {
+			t.Errorf("execute %q error position - received: %v - expected: %v", test.script, pos, test.pos)
+		}
+	}
+
+	// a recovered panic of a called function is a VM error at the call that unwraps to the panic error
+	errA := &testNotFoundError{Name: "a"}
+	e := env.NewEnv()
+	err := e.Define("fail", func() { panic(errA) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, "a = 1\nb = fail()")
+	vmErr, ok := err.(*Error)
+	if !ok || vmErr.Pos.Line != 2 || vmErr.Pos.Column != 5 {
+		t.Fatalf("Execute error - received: %#v - expected: VM error at 2:5", err)
+	}
+	if vmErr.Error() != "vm error recover: a not found" {
+		t.Errorf("Execute error - received: %v - expected: %v", vmErr, "vm error recover: a not found")
+	}
+	var target *testNotFoundError
+	if !errors.As(err, &target) || target != errA {
+		t.Errorf("errors.As - received: %v - expected: %v", target, errA)
+	}
+	if _, ok := ThrownValue(err); ok {
+		t.Errorf("ThrownValue - received: %v - expected: %v", ok, false)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a        interface{}
+		b        interface{}
+		expected bool
+	}{
+		{a: nil, b: nil, expected: true},
+		{a: nil, b: 1, expected: false},
+		{a: int64(1), b: 1, expected: true},
+		{a: int64(1), b: 1.0, expected: true},
+		{a: "1", b: int64(1), expected: true},
+		{a: "a", b: "b", expected: false},
+		{a: []interface{}{int64(1)}, b: []interface{}{int64(1)}, expected: true},
+		{a: map[string]interface{}{"a": 1}, b: map[string]interface{}{"a": 2}, expected: false},
+	}
+	for _, test := range tests {
+		if result := Equal(test.a, test.b); result != test.expected {
+			t.Errorf("Equal %#v %#v - received: %v - expected: %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestTryCall(t *testing.T) {
+	t.Parallel()
+
+	errA := fmt.Errorf("a")
+	value := func() (int64, error) { return 1, nil }
+	fail := func() (int64, error) { return 0, errA }
+	values := func() (int64, string, error) { return 1, "b", nil }
+	check := func(fail bool) error {
+		if fail {
+			return errA
+		}
+		return nil
+	}
+	tests := []Test{
+		{Script: `try 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = try 1 + 2`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `try a`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `try a[0]()`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a()`, Input: map[string]interface{}{"a": value}, RunOutput: []interface{}{int64(1), nil}},
+		{Script: `try a()`, Input: map[string]interface{}{"a": value}, RunOutput: int64(1)},
+		{Script: `try a() + 1`, Input: map[string]interface{}{"a": value}, RunOutput: int64(2)},
+		{Script: `try a()`, Input: map[string]interface{}{"a": fail}, RunError: fmt.Errorf("a")},
+		{Script: `try { try a() } catch (e) { e }`, Input: map[string]interface{}{"a": fail}, RunOutput: errA},
+		{Script: `b = 1; try { b = try a() } catch { }; b`, Input: map[string]interface{}{"a": fail}, RunOutput: int64(1)},
+		{Script: `for { try a(); break }`, Input: map[string]interface{}{"a": fail}, RunError: fmt.Errorf("a")},
+		{Script: `b = 0; for {
+	b = try a()
+	break
+}; b`, Input: map[string]interface{}{"a": value}, RunOutput: int64(1)},
+		{Script: `b = {try a(): 2}; b[1]`, Input: map[string]interface{}{"a": value}, RunOutput: int64(2)},
+		{Script: `try a()`, Input: map[string]interface{}{"a": values}, RunOutput: []interface{}{int64(1), "b"}},
+		{Script: `try a(false)`, Input: map[string]interface{}{"a": check}, RunOutput: nil},
+		{Script: `try a(true)`, Input: map[string]interface{}{"a": check}, RunError: fmt.Errorf("a")},
+		{Script: `try a.b()`, Input: map[string]interface{}{"a": map[string]interface{}{"b": fail}}, RunError: fmt.Errorf("a")},
+		{Script: `try a.b()`, Input: map[string]interface{}{"a": map[string]interface{}{"b": value}}, RunOutput: int64(1)},
+		{Script: `try a.b.c()`, Input: map[string]interface{}{"a": map[string]interface{}{"b": map[string]interface{}{"c": fail}}}, RunError: fmt.Errorf("a")},
+		{Script: `try (a[0])()`, Input: map[string]interface{}{"a": []interface{}{fail}}, RunError: fmt.Errorf("a")},
+		{Script: `try (a[0])()`, Input: map[string]interface{}{"a": []interface{}{value}}, RunOutput: int64(1)},
+		{Script: `try a([1]...)`, Input: map[string]interface{}{"a": func(n int64) (int64, error) { return n, nil }}, RunOutput: int64(1)},
+		{Script: `try a()`, Input: map[string]interface{}{"a": func() int64 { return 1 }}, RunOutput: int64(1)},
+		{Script: `func b() { return [1, a] }; try b()`, Input: map[string]interface{}{"a": errA}, RunOutput: []interface{}{int64(1), errA}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// the thrown value is the Go error and the position is the call
+	e := env.NewEnv()
+	err := e.Define("a", fail)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, "b = 1\nc = try a()")
+	thrown, ok := ThrownValue(err)
+	if !ok || thrown != errA {
+		t.Errorf("ThrownValue - received: %v, %v - expected: %v, %v", thrown, ok, errA, true)
+	}
+	if vmErr, ok := err.(*Error); !ok || vmErr.Pos.Line != 2 || vmErr.Pos.Column != 9 {
+		t.Errorf("Execute error - received: %#v - expected: line 2, column 9", err)
+	}
+}
+
+func TestRaiseErrors(t *testing.T) {
+	t.Parallel()
+
+	registry := env.NewRegistry()
+	values := map[string]reflect.Value{
+		"Value": reflect.ValueOf(func() (int64, error) { return 1, nil }),
+		"Fail":  reflect.ValueOf(func() (int64, error) { return 0, fmt.Errorf("a") }),
+	}
+	registry.Register("raise", &env.Package{Values: values, RaiseErrors: true})
+	registry.Register("noraise", &env.Package{Values: values})
+
+	tests := []Test{
+		{Script: `a = import("raise"); a.Value()`, RunOutput: int64(1)},
+		{Script: `a = import("raise"); a.Fail()`, RunError: fmt.Errorf("a")},
+		{Script: `a = import("raise"); try { a.Fail() } catch (e) { e.Error() }`, RunOutput: "a"},
+		{Script: `a = import("raise"); b = a.Fail; b()`, RunOutput: []interface{}{int64(0), fmt.Errorf("a")}},
+		{Script: `a = import("noraise"); a.Value()`, RunOutput: []interface{}{int64(1), nil}},
+		{Script: `a = import("noraise"); try a.Value()`, RunOutput: int64(1)},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		e.SetRegistry(registry)
+		value, err := Execute(e, nil, test.Script)
+		if test.RunError != nil {
+			if err == nil || err.Error() != test.RunError.Error() {
+				t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, test.RunError, test.Script)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, nil, test.Script)
+			continue
+		}
+		if !reflect.DeepEqual(value, test.RunOutput) {
+			t.Errorf("Execute value - received: %#v - expected: %#v - script: %v", value, test.RunOutput, test.Script)
+		}
+	}
+}